@@ -0,0 +1,24 @@
+package tree
+
+// avlRebalance walks from n up to the root, recalculating heights
+// and rotating every node whose balance factor went out of [-1, 1].
+func (t *Tree[K, V]) avlRebalance(n *node[K, V]) {
+	for n != nil {
+		n.update()
+
+		switch bf := n.balanceFactor(); {
+		case bf > 1:
+			if n.left.balanceFactor() < 0 {
+				t.rotateLeft(n.left)
+			}
+			n = t.rotateRight(n)
+		case bf < -1:
+			if n.right.balanceFactor() > 0 {
+				t.rotateRight(n.right)
+			}
+			n = t.rotateLeft(n)
+		}
+
+		n = n.parent
+	}
+}
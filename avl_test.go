@@ -0,0 +1,133 @@
+package tree
+
+import (
+	"cmp"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// checkAVL verifies cached heights and balance factors of the subtree and returns its height.
+func checkAVL[K cmp.Ordered](t *testing.T, n *node[K, int]) int {
+	t.Helper()
+	if n == nil {
+		return 0
+	}
+
+	l, r := checkAVL(t, n.left), checkAVL(t, n.right)
+	h := l + 1
+	if r > l {
+		h = r + 1
+	}
+	if n.height != h {
+		t.Fatalf("node %v: cached height = %d, want %d", n.element.key, n.height, h)
+	}
+	if l-r > 1 || r-l > 1 {
+		t.Fatalf("node %v: unbalanced, left height = %d, right height = %d", n.element.key, l, r)
+	}
+
+	return h
+}
+
+func TestTree_AVLInsert(t1 *testing.T) {
+	type testCase struct {
+		name   string
+		insert func(t *Tree[int, int], key int)
+		keys   []int
+	}
+
+	var ascending, descending, zigzag []int
+	for i := 0; i < 1000; i++ {
+		ascending = append(ascending, i)
+		descending = append(descending, 1000-i)
+		zigzag = append(zigzag, i*(1-2*(i%2)))
+	}
+
+	tests := []testCase{
+		{
+			name:   "ascending keys",
+			insert: func(t *Tree[int, int], key int) { t.Insert(key, key) },
+			keys:   ascending,
+		},
+		{
+			name:   "descending keys",
+			insert: func(t *Tree[int, int], key int) { t.Insert(key, key) },
+			keys:   descending,
+		},
+		{
+			name:   "zigzag keys",
+			insert: func(t *Tree[int, int], key int) { t.Insert(key, key) },
+			keys:   zigzag,
+		},
+		{
+			name:   "ascending keys without recursion",
+			insert: func(t *Tree[int, int], key int) { t.InsertWithoutRecursion(key, key) },
+			keys:   ascending,
+		},
+		{
+			name:   "zigzag keys without recursion",
+			insert: func(t *Tree[int, int], key int) { t.InsertWithoutRecursion(key, key) },
+			keys:   zigzag,
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			tree := New[int, int](WithAVL())
+			for _, key := range tt.keys {
+				tt.insert(tree, key)
+			}
+
+			h := checkAVL(t1, tree.root)
+			maxHeight := int(1.45 * math.Log2(float64(len(tt.keys)+2)))
+			if h > maxHeight {
+				t1.Errorf("height = %d, want at most %d", h, maxHeight)
+			}
+			if tree.root.parent != nil {
+				t1.Errorf("root has parent %v", tree.root.parent.element.key)
+			}
+
+			got := tree.InOrderTreeWalk(Asc)
+			for i := 1; i < len(got); i++ {
+				if got[i-1] > got[i] {
+					t1.Fatalf("InOrderTreeWalk() is not sorted at %d: %v > %v", i, got[i-1], got[i])
+				}
+			}
+			if len(got) != len(tt.keys) {
+				t1.Errorf("InOrderTreeWalk() returned %d keys, want %d", len(got), len(tt.keys))
+			}
+		})
+	}
+}
+
+func TestTree_AVLDelete(t1 *testing.T) {
+	tree := New[int, int](WithAVL())
+	for i := 0; i < 512; i++ {
+		tree.Insert(i, i)
+	}
+
+	var want []int
+	for i := 0; i < 512; i++ {
+		if i%3 == 0 {
+			tree.Delete(i)
+			checkAVL(t1, tree.root)
+			continue
+		}
+		want = append(want, i)
+	}
+
+	if got := tree.InOrderTreeWalk(Asc); !reflect.DeepEqual(got, want) {
+		t1.Errorf("InOrderTreeWalk() = %v, want %v", got, want)
+	}
+
+	for _, key := range want {
+		if !tree.Exists(key) {
+			t1.Fatalf("Exists(%v) = false, want true", key)
+		}
+		tree.Delete(key)
+		checkAVL(t1, tree.root)
+	}
+
+	if tree.root != nil {
+		t1.Errorf("root = %v, want nil", tree.root.element.key)
+	}
+}
@@ -0,0 +1,140 @@
+package tree
+
+const (
+	// unbalanced is a plain binary search tree without any rebalancing.
+	unbalanced balancing = iota
+	// avl keeps the tree height-balanced (AVL tree).
+	avl
+	// redBlack keeps the tree balanced by coloring its nodes (red-black tree).
+	redBlack
+)
+
+// balancing is a type which uses to set the way a tree keeps itself balanced.
+type balancing uint8
+
+// Option is a function for configuring a tree on creation.
+type Option func(*settings)
+
+// settings is the structure of configurable tree's parameters.
+type settings struct {
+	balance balancing
+}
+
+// WithAVL is an option for creation self-balancing AVL tree.
+// The tree keeps height information per node and rotates on Insert and Delete,
+// so that lookups and traversals stay O(log n) regardless of insertion order.
+func WithAVL() Option {
+	return func(s *settings) {
+		s.balance = avl
+	}
+}
+
+// WithRedBlack is an option for creation self-balancing red-black tree.
+// The tree keeps a color bit per node and does at most three rotations on Delete,
+// which makes it cheaper than AVL for write-heavy workloads.
+func WithRedBlack() Option {
+	return func(s *settings) {
+		s.balance = redBlack
+	}
+}
+
+func newSettings(opts []Option) settings {
+	var s settings
+	for _, opt := range opts {
+		opt(&s)
+	}
+
+	return s
+}
+
+// afterInsert restores the balance of the tree after inserting leaf n.
+func (t *Tree[K, V]) afterInsert(n *node[K, V]) {
+	if t.balance == avl {
+		t.avlRebalance(n)
+		return
+	}
+
+	updateToRoot(n)
+	if t.balance == redBlack {
+		t.redBlackInsertFixup(n)
+	}
+}
+
+// afterDelete restores the balance of the tree after removing a node.
+// child has taken the place of the removed node (it can be nil),
+// parent is the parent of that place and removed is the color of the removed node.
+func (t *Tree[K, V]) afterDelete(child, parent *node[K, V], removed color) {
+	if t.balance == avl {
+		t.avlRebalance(parent)
+		return
+	}
+
+	updateToRoot(parent)
+	if t.balance == redBlack && removed == black {
+		t.redBlackDeleteFixup(child, parent)
+	}
+}
+
+// updateToRoot recalculates cached heights and sizes of n and all its ancestors.
+func updateToRoot[K, V any](n *node[K, V]) {
+	for ; n != nil; n = n.parent {
+		n.update()
+	}
+}
+
+// rotateLeft makes right child of x the new root of x's subtree and returns it.
+func (t *Tree[K, V]) rotateLeft(x *node[K, V]) *node[K, V] {
+	y := x.right
+	x.right = y.left
+	if y.left != nil {
+		y.left.parent = x
+	}
+
+	t.replaceChild(x.parent, x, y)
+	y.left = x
+	x.parent = y
+
+	x.update()
+	y.update()
+
+	return y
+}
+
+// rotateRight makes left child of x the new root of x's subtree and returns it.
+func (t *Tree[K, V]) rotateRight(x *node[K, V]) *node[K, V] {
+	y := x.left
+	x.left = y.right
+	if y.right != nil {
+		y.right.parent = x
+	}
+
+	t.replaceChild(x.parent, x, y)
+	y.right = x
+	x.parent = y
+
+	x.update()
+	y.update()
+
+	return y
+}
+
+// transplant replaces subtree rooted at u with subtree rooted at v.
+func (t *Tree[K, V]) transplant(u, v *node[K, V]) {
+	t.replaceChild(u.parent, u, v)
+}
+
+// replaceChild puts newChild to the place of oldChild under parent (or to the root if parent is nil).
+func (t *Tree[K, V]) replaceChild(parent, oldChild, newChild *node[K, V]) {
+	switch {
+	case parent == nil:
+		t.root = newChild
+	case parent.left == oldChild:
+		parent.left = newChild
+	default:
+		parent.right = newChild
+	}
+
+	if newChild != nil {
+		newChild.parent = parent
+	}
+}
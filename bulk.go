@@ -0,0 +1,166 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+	"math/bits"
+	"slices"
+)
+
+// FromSorted is a function for creation tree from keys in ascending order in O(n).
+// The tree has the least possible height, so it is much faster than Insert in a loop
+// (which is O(n²) for sorted keys in the plain tree).
+// - param values should have the same length as keys, values[i] is the value of keys[i]
+// - opts can be used for choosing self-balancing variant of the tree (WithAVL, WithRedBlack)
+// - error wraps ErrLengthMismatch or is *KeyError wrapping ErrNotSorted or ErrDuplicateKey with the first wrong key
+func FromSorted[K cmp.Ordered, V any](keys []K, values []V, opts ...Option) (*Tree[K, V], error) {
+	if len(keys) != len(values) {
+		return nil, fmt.Errorf("FromSorted: %w: %d keys and %d values", ErrLengthMismatch, len(keys), len(values))
+	}
+
+	t := New[K, V](opts...)
+	if err := checkSorted(t, "FromSorted", len(keys), func(i int) K { return keys[i] }, true); err != nil {
+		return nil, err
+	}
+	t.build(len(keys), func(i int) (K, V) { return keys[i], values[i] })
+
+	return t, nil
+}
+
+// FromSortedPairs is a function for creation tree from elements in ascending order of keys in O(n), like FromSorted.
+// - error is *KeyError wrapping ErrNotSorted or ErrDuplicateKey with the first wrong key
+func FromSortedPairs[K cmp.Ordered, V any](entries []Entry[K, V], opts ...Option) (*Tree[K, V], error) {
+	t := New[K, V](opts...)
+	if err := checkSorted(t, "FromSortedPairs", len(entries), func(i int) K { return entries[i].Key }, true); err != nil {
+		return nil, err
+	}
+	t.build(len(entries), func(i int) (K, V) { return entries[i].Key, entries[i].Value })
+
+	return t, nil
+}
+
+// FromMap is a function for creation tree with elements of the map in O(n log n).
+// Float maps can have several NaN keys, they are equal for the tree, so only one of them is kept
+// (which one is undefined, like the order of map iteration).
+func FromMap[K cmp.Ordered, V any](m map[K]V, opts ...Option) *Tree[K, V] {
+	entries := make([]Entry[K, V], 0, len(m))
+	for key, value := range m {
+		entries = append(entries, Entry[K, V]{Key: key, Value: value})
+	}
+
+	return FromUnsorted(entries, opts...)
+}
+
+// FromUnsorted is a function for creation tree from elements in any order in O(n log n).
+// If the key is repeated, the last value is kept, the same as Insert does.
+// The entries slice isn't changed.
+func FromUnsorted[K cmp.Ordered, V any](entries []Entry[K, V], opts ...Option) *Tree[K, V] {
+	sorted := sortEntries(entries)
+
+	// keep the last one of equal keys
+	unique := sorted[:0]
+	for i, e := range sorted {
+		if i+1 < len(sorted) && cmp.Compare(e.Key, sorted[i+1].Key) == 0 {
+			continue
+		}
+		unique = append(unique, e)
+	}
+
+	t := New[K, V](opts...)
+	t.build(len(unique), func(i int) (K, V) { return unique[i].Key, unique[i].Value })
+
+	return t
+}
+
+// MultiFromSorted is a function for creation tree with duplicate keys from elements in ascending order of keys in O(n).
+// Values of equal keys are kept in the order of entries.
+// - error is *KeyError wrapping ErrNotSorted with the first wrong key
+func MultiFromSorted[K cmp.Ordered, V any](entries []Entry[K, V], opts ...Option) (*MultiTree[K, V], error) {
+	m := NewMulti[K, V](opts...)
+	if err := checkSorted(m.tree, "MultiFromSorted", len(entries), func(i int) K { return entries[i].Key }, false); err != nil {
+		return nil, err
+	}
+	m.build(entries)
+
+	return m, nil
+}
+
+// MultiFromUnsorted is a function for creation tree with duplicate keys from elements in any order in O(n log n).
+// Values of equal keys are kept in the order of entries. The entries slice isn't changed.
+func MultiFromUnsorted[K cmp.Ordered, V any](entries []Entry[K, V], opts ...Option) *MultiTree[K, V] {
+	m := NewMulti[K, V](opts...)
+	m.build(sortEntries(entries))
+
+	return m
+}
+
+// sortEntries returns sorted copy of entries, equal keys keep their order.
+func sortEntries[K cmp.Ordered, V any](entries []Entry[K, V]) []Entry[K, V] {
+	sorted := slices.Clone(entries)
+	slices.SortStableFunc(sorted, func(a, b Entry[K, V]) int { return cmp.Compare(a.Key, b.Key) })
+
+	return sorted
+}
+
+// checkSorted checks that n keys returned by key are in ascending order of the tree's comparator.
+// - param unique forbids equal keys
+func checkSorted[K, V any](t *Tree[K, V], op string, n int, key func(i int) K, unique bool) error {
+	for i := 1; i < n; i++ {
+		switch c := t.cmp(key(i-1), key(i)); {
+		case c > 0:
+			return &KeyError[K]{Op: op, Key: key(i), Err: ErrNotSorted}
+		case c == 0 && unique:
+			return &KeyError[K]{Op: op, Key: key(i), Err: ErrDuplicateKey}
+		}
+	}
+
+	return nil
+}
+
+// build replaces elements of the tree with n elements in ascending order of keys returned by at.
+// Every subtree takes its middle element as the root, so the tree has the least possible height.
+func (t *Tree[K, V]) build(n int, at func(i int) (K, V)) {
+	t.root = t.buildSubtree(0, n, 0, bits.Len(uint(n)), at)
+}
+
+// buildSubtree makes the subtree of elements lo..hi-1 whose root has the depth.
+// For red-black tree the nodes of the deepest level (of the tree with the height) are red,
+// all others are black: every path from the root to a leaf has the same number of black nodes.
+func (t *Tree[K, V]) buildSubtree(lo, hi, depth, height int, at func(i int) (K, V)) *node[K, V] {
+	if lo >= hi {
+		return nil
+	}
+
+	mid := int(uint(lo+hi) >> 1)
+	key, value := at(mid)
+	n := &node[K, V]{element: element[K, V]{key: key, value: value}}
+	if t.balance == redBlack && depth > 0 && depth == height-1 {
+		n.color = red
+	}
+
+	n.left = t.buildSubtree(lo, mid, depth+1, height, at)
+	n.right = t.buildSubtree(mid+1, hi, depth+1, height, at)
+	for _, child := range []*node[K, V]{n.left, n.right} {
+		if child != nil {
+			child.parent = n
+		}
+	}
+	n.update()
+
+	return n
+}
+
+// build replaces elements of the tree with entries sorted by keys, equal keys are grouped in one node.
+func (m *MultiTree[K, V]) build(entries []Entry[K, V]) {
+	var groups []Entry[K, []V]
+	for _, e := range entries {
+		if last := len(groups) - 1; last >= 0 && m.tree.cmp(groups[last].Key, e.Key) == 0 {
+			groups[last].Value = append(groups[last].Value, e.Value)
+			continue
+		}
+		groups = append(groups, Entry[K, []V]{Key: e.Key, Value: []V{e.Value}})
+	}
+
+	m.tree.build(len(groups), func(i int) (K, []V) { return groups[i].Key, groups[i].Value })
+	m.len = len(entries)
+}
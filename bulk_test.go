@@ -0,0 +1,173 @@
+package tree
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestFromSorted(t1 *testing.T) {
+	for _, mode := range fuzzModes {
+		for _, n := range []int{0, 1, 2, 3, 7, 8, 100, 1000} {
+			keys := ascending(n)
+			values := make([]string, n)
+			for i := range values {
+				values[i] = string(rune('a' + i%26))
+			}
+
+			tree, err := FromSorted(keys, values, mode.opts...)
+			if err != nil {
+				t1.Fatalf("%s: FromSorted() of %d keys error = %v", mode.name, n, err)
+			}
+			if err := tree.Validate(); err != nil {
+				t1.Fatalf("%s: Validate() of %d keys = %v", mode.name, n, err)
+			}
+			if got, want := tree.Height(), bits.Len(uint(n)); got != want {
+				t1.Errorf("%s: Height() of %d keys = %v, want %v", mode.name, n, got, want)
+			}
+			if got := tree.Len(); got != n {
+				t1.Errorf("%s: Len() = %v, want %v", mode.name, got, n)
+			}
+			for i, key := range keys {
+				if value, ok := tree.GetValue(key); !ok || value != values[i] {
+					t1.Fatalf("%s: GetValue(%v) = %v, %v, want %v, true", mode.name, key, value, ok, values[i])
+				}
+			}
+
+			// the built tree keeps working as usual
+			tree.Insert(n, "new")
+			tree.Delete(0)
+			if err := tree.Validate(); err != nil {
+				t1.Fatalf("%s: Validate() after Insert and Delete = %v", mode.name, err)
+			}
+		}
+	}
+}
+
+func TestFromSorted_InvalidInput(t1 *testing.T) {
+	tests := []struct {
+		name    string
+		keys    []int
+		values  []int
+		want    error
+		wantKey int
+	}{
+		{name: "unsorted keys", keys: []int{1, 3, 2}, values: []int{1, 3, 2}, want: ErrNotSorted, wantKey: 2},
+		{name: "duplicate keys", keys: []int{1, 2, 2, 3}, values: []int{1, 2, 2, 3}, want: ErrDuplicateKey, wantKey: 2},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			tree, err := FromSorted(tt.keys, tt.values)
+			if tree != nil || !errors.Is(err, tt.want) {
+				t1.Fatalf("FromSorted() = %v, %v, want nil, %v", tree, err, tt.want)
+			}
+			var keyErr *KeyError[int]
+			if !errors.As(err, &keyErr) || keyErr.Key != tt.wantKey {
+				t1.Errorf("FromSorted() error = %v, want error about key %v", err, tt.wantKey)
+			}
+
+			var entries []Entry[int, int]
+			for i := range tt.keys {
+				entries = append(entries, Entry[int, int]{tt.keys[i], tt.values[i]})
+			}
+			if _, err := FromSortedPairs(entries); !errors.Is(err, tt.want) {
+				t1.Errorf("FromSortedPairs() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	if _, err := FromSorted([]int{1, 2}, []int{1}); !errors.Is(err, ErrLengthMismatch) {
+		t1.Errorf("FromSorted() with different lengths error = %v, want %v", err, ErrLengthMismatch)
+	}
+}
+
+func TestFromUnsorted(t1 *testing.T) {
+	entries := []Entry[int, string]{{5, "a"}, {1, "b"}, {5, "c"}, {3, "d"}, {1, "e"}}
+	tree := FromUnsorted(entries, WithRedBlack())
+	if err := tree.Validate(); err != nil {
+		t1.Fatalf("Validate() = %v", err)
+	}
+
+	want := []Entry[int, string]{{1, "e"}, {3, "d"}, {5, "c"}}
+	if got := tree.Range(0, 10, Closed, Asc); !reflect.DeepEqual(got, want) {
+		t1.Errorf("FromUnsorted() elements = %v, want %v", got, want)
+	}
+	if entries[0] != (Entry[int, string]{5, "a"}) {
+		t1.Errorf("FromUnsorted() changed entries: %v", entries)
+	}
+}
+
+func TestFromMap(t1 *testing.T) {
+	m := make(map[int]int)
+	for _, key := range rand.New(rand.NewSource(5)).Perm(500) {
+		m[key] = -key
+	}
+
+	tree := FromMap(m, WithAVL())
+	if err := tree.Validate(); err != nil {
+		t1.Fatalf("Validate() = %v", err)
+	}
+	if got := tree.Len(); got != len(m) {
+		t1.Errorf("Len() = %v, want %v", got, len(m))
+	}
+	for key, value := range tree.All() {
+		if value != m[key] {
+			t1.Fatalf("value of %v = %v, want %v", key, value, m[key])
+		}
+	}
+}
+
+func TestMultiFromSorted(t1 *testing.T) {
+	entries := []Entry[int, string]{{1, "a"}, {2, "b"}, {2, "c"}, {2, "d"}, {4, "e"}}
+	m, err := MultiFromSorted(entries, WithRedBlack())
+	if err != nil {
+		t1.Fatalf("MultiFromSorted() error = %v", err)
+	}
+	if err := m.Validate(); err != nil {
+		t1.Fatalf("Validate() = %v", err)
+	}
+	if got, want := m.GetAll(2), []string{"b", "c", "d"}; !reflect.DeepEqual(got, want) {
+		t1.Errorf("GetAll(2) = %v, want %v", got, want)
+	}
+	if got := m.Len(); got != 5 {
+		t1.Errorf("Len() = %v, want 5", got)
+	}
+
+	if _, err := MultiFromSorted([]Entry[int, string]{{2, "a"}, {1, "b"}}); !errors.Is(err, ErrNotSorted) {
+		t1.Errorf("MultiFromSorted() of unsorted entries error = %v, want %v", err, ErrNotSorted)
+	}
+}
+
+func TestMultiFromUnsorted(t1 *testing.T) {
+	m := MultiFromUnsorted([]Entry[int, string]{{5, "a"}, {1, "b"}, {5, "c"}, {3, "d"}, {1, "e"}})
+	if err := m.Validate(); err != nil {
+		t1.Fatalf("Validate() = %v", err)
+	}
+
+	var got []Entry[int, string]
+	for key, value := range m.All() {
+		got = append(got, Entry[int, string]{key, value})
+	}
+	want := []Entry[int, string]{{1, "b"}, {1, "e"}, {3, "d"}, {5, "a"}, {5, "c"}}
+	if !reflect.DeepEqual(got, want) {
+		t1.Errorf("MultiFromUnsorted() elements = %v, want %v", got, want)
+	}
+}
+
+func TestFromMap_NaNKeys(t1 *testing.T) {
+	m := map[float64]int{1: 1, math.NaN(): 2, math.NaN(): 2, 3: 3}
+
+	tree := FromMap(m)
+	if err := tree.Validate(); err != nil {
+		t1.Fatalf("Validate() = %v", err)
+	}
+	if got := tree.Len(); got != 3 {
+		t1.Errorf("Len() = %v, want 3", got)
+	}
+	if value, ok := tree.GetValue(math.NaN()); !ok || value != 2 {
+		t1.Errorf("GetValue(NaN) = %v, %v, want 2, true", value, ok)
+	}
+}
@@ -0,0 +1,33 @@
+package tree
+
+import "cmp"
+
+// AnyTree is a compatibility wrapper for code written against the tree with one type parameter:
+// values have type any and GetValue returns an error for missing keys.
+// Migration: replace `tree.New[K]()` with `tree.NewAny[K]()` and `*tree.Tree[K]` with `tree.AnyTree[K]`.
+//
+// Deprecated: use Tree[K, V] with a concrete value type instead.
+type AnyTree[K cmp.Ordered] struct {
+	*Tree[K, any]
+}
+
+// NewAny is a function for creation empty tree with values of any type
+// - type param K (key) should be `ordered type` (`int`, `string`, `float` etc)
+// - opts can be used for choosing self-balancing variant of the tree (WithAVL, WithRedBlack)
+//
+// Deprecated: use New[K, V] instead.
+func NewAny[K cmp.Ordered](opts ...Option) AnyTree[K] {
+	return AnyTree[K]{Tree: New[K, any](opts...)}
+}
+
+// GetValue is a function for searching element in node and returning value of this element
+// - param key should be `ordered type` (`int`, `string`, `float` etc)
+// - error is *KeyError wrapping ErrNotFound if element with the key doesn't exist
+func (t AnyTree[K]) GetValue(key K) (any, error) {
+	value, ok := t.Tree.GetValue(key)
+	if !ok {
+		return nil, &KeyError[K]{Op: "GetValue", Key: key, Err: ErrNotFound}
+	}
+
+	return value, nil
+}
@@ -0,0 +1,47 @@
+package tree
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNotFound is returned when element with the key doesn't exist in the tree.
+	ErrNotFound = errors.New("element not found")
+	// ErrNoSuccessor is returned when the key is the last one in the traversal order.
+	ErrNoSuccessor = errors.New("successor not found")
+	// ErrNoPredecessor is returned when the key is the first one in sorted order.
+	ErrNoPredecessor = errors.New("predecessor not found")
+	// ErrEmptyTree is returned when the tree has no elements.
+	ErrEmptyTree = errors.New("tree is empty")
+	// ErrNotSorted is returned by FromSorted constructors when keys aren't in ascending order.
+	ErrNotSorted = errors.New("keys are not sorted")
+	// ErrDuplicateKey is returned by FromSorted constructors of Tree when the key is repeated.
+	ErrDuplicateKey = errors.New("duplicate key")
+	// ErrLengthMismatch is returned by FromSorted when keys and values have different lengths.
+	ErrLengthMismatch = errors.New("keys and values have different lengths")
+	// ErrInvalidTree is returned by Validate when the structure of the tree is broken.
+	ErrInvalidTree = errors.New("invalid tree")
+)
+
+// KeyError records an error and the operation and the key that caused it.
+// Err is one of the package's errors, so it can be checked with errors.Is:
+//
+//	if errors.Is(err, tree.ErrNotFound) {
+//		...
+//	}
+type KeyError[K any] struct {
+	Op  string
+	Key K
+	Err error
+}
+
+// Error is a function for getting the text of the error, for example "Successor 22: successor not found".
+func (e *KeyError[K]) Error() string {
+	return fmt.Sprintf("%s %v: %v", e.Op, e.Key, e.Err)
+}
+
+// Unwrap is a function for getting the package's error which KeyError wraps.
+func (e *KeyError[K]) Unwrap() error {
+	return e.Err
+}
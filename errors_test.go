@@ -0,0 +1,94 @@
+package tree
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestKeyError(t1 *testing.T) {
+	tree := New[int, int]()
+	for _, key := range []int{8, 4, 22} {
+		tree.Insert(key, key)
+	}
+	anyTree := NewAny[int]()
+
+	tests := []struct {
+		name    string
+		call    func() error
+		want    error
+		wantMsg string
+	}{
+		{
+			name:    "Successor of missing key",
+			call:    func() error { _, err := tree.Successor(5); return err },
+			want:    ErrNotFound,
+			wantMsg: "Successor 5: element not found",
+		},
+		{
+			name:    "Successor of max key",
+			call:    func() error { _, err := tree.Successor(22); return err },
+			want:    ErrNoSuccessor,
+			wantMsg: "Successor 22: successor not found",
+		},
+		{
+			name:    "Predecessor of min key",
+			call:    func() error { _, err := tree.Predecessor(4); return err },
+			want:    ErrNoPredecessor,
+			wantMsg: "Predecessor 4: predecessor not found",
+		},
+		{
+			name:    "PreOrderSuccessor of last key",
+			call:    func() error { _, err := tree.PreOrderSuccessor(22); return err },
+			want:    ErrNoSuccessor,
+			wantMsg: "PreOrderSuccessor 22: successor not found",
+		},
+		{
+			name:    "PostOrderSuccessor of missing key",
+			call:    func() error { _, err := tree.PostOrderSuccessor(1); return err },
+			want:    ErrNotFound,
+			wantMsg: "PostOrderSuccessor 1: element not found",
+		},
+		{
+			name:    "AnyTree GetValue of missing key",
+			call:    func() error { _, err := anyTree.GetValue(3); return err },
+			want:    ErrNotFound,
+			wantMsg: "GetValue 3: element not found",
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			err := tt.call()
+			if !errors.Is(err, tt.want) {
+				t1.Fatalf("error = %v, want wrapped %v", err, tt.want)
+			}
+			if err.Error() != tt.wantMsg {
+				t1.Errorf("Error() = %q, want %q", err.Error(), tt.wantMsg)
+			}
+
+			var keyErr *KeyError[int]
+			if !errors.As(err, &keyErr) {
+				t1.Fatalf("error = %T, want *KeyError[int]", err)
+			}
+		})
+	}
+}
+
+func TestTree_MinMaxElement(t1 *testing.T) {
+	tree := New[int, string](WithAVL())
+	if _, _, err := tree.MinElement(); !errors.Is(err, ErrEmptyTree) {
+		t1.Errorf("MinElement() of empty tree error = %v, want %v", err, ErrEmptyTree)
+	}
+	if _, _, err := tree.MaxElement(); !errors.Is(err, ErrEmptyTree) {
+		t1.Errorf("MaxElement() of empty tree error = %v, want %v", err, ErrEmptyTree)
+	}
+
+	tree.Insert(0, "zero")
+	tree.Insert(-3, "minus three")
+	tree.Insert(7, "seven")
+	if key, value, err := tree.MinElement(); err != nil || key != -3 || value != "minus three" {
+		t1.Errorf("MinElement() = %v, %v, %v, want -3, minus three, nil", key, value, err)
+	}
+	if key, value, err := tree.MaxElement(); err != nil || key != 7 || value != "seven" {
+		t1.Errorf("MaxElement() = %v, %v, %v, want 7, seven, nil", key, value, err)
+	}
+}
@@ -1,5 +1,3 @@
 module github.com/fedchishina/tree
 
-go 1.20
-
-require golang.org/x/exp v0.0.0-20230626212559-97b1e661b5df
+go 1.23
@@ -0,0 +1,61 @@
+package tree
+
+import "iter"
+
+// All is a function for iterating over tree's elements in ascending order of keys:
+//
+//	for key, value := range t.All() {
+//		...
+//	}
+//
+// Elements are visited lazily by parent pointers, breaking the loop stops the walk.
+func (t *Tree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := min(t.root); n != nil; n = next(n) {
+			if !yield(n.element.key, n.element.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward is a function for iterating over tree's elements in descending order of keys.
+func (t *Tree[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := max(t.root); n != nil; n = prev(n) {
+			if !yield(n.element.key, n.element.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys is a function for iterating over tree's keys in ascending order.
+func (t *Tree[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for n := min(t.root); n != nil; n = next(n) {
+			if !yield(n.element.key) {
+				return
+			}
+		}
+	}
+}
+
+// Values is a function for iterating over tree's values in ascending order of keys.
+func (t *Tree[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for n := min(t.root); n != nil; n = next(n) {
+			if !yield(n.element.value) {
+				return
+			}
+		}
+	}
+}
+
+// Between is a function for iterating over elements with lo <= key < hi in ascending order of keys.
+// Subtrees outside the bounds are not visited.
+func (t *Tree[K, V]) Between(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.RangeFunc(lo, hi, ClosedOpen, Asc, yield)
+	}
+}
@@ -0,0 +1,93 @@
+package tree
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTree_Iterators(t1 *testing.T) {
+	tree := New[int, string](WithRedBlack())
+	for _, key := range []int{40, 20, 60, 10, 30, 50, 70} {
+		tree.Insert(key, string(rune('a'+key/10)))
+	}
+
+	t1.Run("all", func(t1 *testing.T) {
+		var keys []int
+		var values []string
+		for key, value := range tree.All() {
+			keys = append(keys, key)
+			values = append(values, value)
+		}
+		if want := []int{10, 20, 30, 40, 50, 60, 70}; !reflect.DeepEqual(keys, want) {
+			t1.Errorf("All() keys = %v, want %v", keys, want)
+		}
+		if want := []string{"b", "c", "d", "e", "f", "g", "h"}; !reflect.DeepEqual(values, want) {
+			t1.Errorf("All() values = %v, want %v", values, want)
+		}
+	})
+
+	t1.Run("backward", func(t1 *testing.T) {
+		var got []int
+		for key := range tree.Backward() {
+			got = append(got, key)
+		}
+		if want := []int{70, 60, 50, 40, 30, 20, 10}; !reflect.DeepEqual(got, want) {
+			t1.Errorf("Backward() = %v, want %v", got, want)
+		}
+	})
+
+	t1.Run("keys and values", func(t1 *testing.T) {
+		var keys []int
+		for key := range tree.Keys() {
+			keys = append(keys, key)
+		}
+		if want := tree.InOrderTreeWalk(Asc); !reflect.DeepEqual(keys, want) {
+			t1.Errorf("Keys() = %v, want %v", keys, want)
+		}
+
+		var values []string
+		for value := range tree.Values() {
+			values = append(values, value)
+		}
+		if want := []string{"b", "c", "d", "e", "f", "g", "h"}; !reflect.DeepEqual(values, want) {
+			t1.Errorf("Values() = %v, want %v", values, want)
+		}
+	})
+
+	t1.Run("between", func(t1 *testing.T) {
+		var got []int
+		for key := range tree.Between(20, 50) {
+			got = append(got, key)
+		}
+		if want := []int{20, 30, 40}; !reflect.DeepEqual(got, want) {
+			t1.Errorf("Between(20, 50) = %v, want %v", got, want)
+		}
+	})
+
+	t1.Run("break stops the walk", func(t1 *testing.T) {
+		var got []int
+		for key := range tree.All() {
+			if key > 30 {
+				break
+			}
+			got = append(got, key)
+		}
+		for key := range tree.Backward() {
+			if key < 60 {
+				break
+			}
+			got = append(got, key)
+		}
+		for key := range tree.Keys() {
+			got = append(got, key)
+			break
+		}
+		for key := range tree.Between(0, 100) {
+			got = append(got, key)
+			break
+		}
+		if want := []int{10, 20, 30, 70, 60, 10, 10}; !reflect.DeepEqual(got, want) {
+			t1.Errorf("visited %v, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,93 @@
+package tree
+
+// Iterator is the structure for stateful walking through tree's elements in sorted order.
+// It moves by parent pointers of nodes, so it needs O(1) extra memory.
+// Iterator must not be used after the tree is modified.
+type Iterator[K, V any] struct {
+	tree *Tree[K, V]
+	node *node[K, V]
+	// positioned is false until the first call of First, Last, Seek, Next or Prev.
+	positioned bool
+}
+
+// Iterator is a function for creation iterator over tree's elements.
+// New iterator is not positioned: Next moves it to the first element and Prev - to the last one, so
+//
+//	for it := t.Iterator(); it.Next(); {
+//		fmt.Println(it.Key(), it.Value())
+//	}
+//
+// walks the whole tree in ascending order.
+func (t *Tree[K, V]) Iterator() *Iterator[K, V] {
+	return &Iterator[K, V]{tree: t}
+}
+
+// First is a function for moving iterator to the element with the smallest key.
+// - returns false if the tree is empty
+func (it *Iterator[K, V]) First() bool {
+	return it.moveTo(min(it.tree.root))
+}
+
+// Last is a function for moving iterator to the element with the largest key.
+// - returns false if the tree is empty
+func (it *Iterator[K, V]) Last() bool {
+	return it.moveTo(max(it.tree.root))
+}
+
+// Seek is a function for moving iterator to the first element with key greater than or equal to the key.
+// - returns false if there is no such element
+func (it *Iterator[K, V]) Seek(key K) bool {
+	return it.moveTo(it.tree.ceiling(key, false))
+}
+
+// Next is a function for moving iterator to the next element in ascending order.
+// Not positioned iterator moves to the first element.
+// - returns false when there are no more elements; the iterator stays exhausted until First, Last or Seek
+func (it *Iterator[K, V]) Next() bool {
+	if !it.positioned {
+		return it.First()
+	}
+	if it.node == nil {
+		return false
+	}
+
+	return it.moveTo(next(it.node))
+}
+
+// Prev is a function for moving iterator to the previous element in ascending order.
+// Not positioned iterator moves to the last element.
+// - returns false when there are no more elements; the iterator stays exhausted until First, Last or Seek
+func (it *Iterator[K, V]) Prev() bool {
+	if !it.positioned {
+		return it.Last()
+	}
+	if it.node == nil {
+		return false
+	}
+
+	return it.moveTo(prev(it.node))
+}
+
+// Valid is a function for checking that iterator points to an element.
+func (it *Iterator[K, V]) Valid() bool {
+	return it.node != nil
+}
+
+// Key is a function for getting key of the current element (zero value if iterator is not valid).
+func (it *Iterator[K, V]) Key() K {
+	key, _, _ := unpack(it.node)
+	return key
+}
+
+// Value is a function for getting value of the current element (zero value if iterator is not valid).
+func (it *Iterator[K, V]) Value() V {
+	_, value, _ := unpack(it.node)
+	return value
+}
+
+func (it *Iterator[K, V]) moveTo(n *node[K, V]) bool {
+	it.positioned = true
+	it.node = n
+
+	return n != nil
+}
@@ -0,0 +1,96 @@
+package tree
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIterator(t1 *testing.T) {
+	for _, opts := range [][]Option{nil, {WithAVL()}, {WithRedBlack()}} {
+		tree := New[int, int](opts...)
+		for _, key := range []int{40, 20, 60, 10, 30, 50, 70} {
+			tree.Insert(key, -key)
+		}
+
+		t1.Run("next", func(t1 *testing.T) {
+			var got []int
+			for it := tree.Iterator(); it.Next(); {
+				if it.Value() != -it.Key() {
+					t1.Errorf("Value() = %v, want %v", it.Value(), -it.Key())
+				}
+				got = append(got, it.Key())
+			}
+			if want := []int{10, 20, 30, 40, 50, 60, 70}; !reflect.DeepEqual(got, want) {
+				t1.Errorf("Next() visited %v, want %v", got, want)
+			}
+		})
+
+		t1.Run("prev", func(t1 *testing.T) {
+			var got []int
+			for it := tree.Iterator(); it.Prev(); {
+				got = append(got, it.Key())
+			}
+			if want := []int{70, 60, 50, 40, 30, 20, 10}; !reflect.DeepEqual(got, want) {
+				t1.Errorf("Prev() visited %v, want %v", got, want)
+			}
+		})
+
+		t1.Run("seek", func(t1 *testing.T) {
+			tests := []struct {
+				key   int
+				want  int
+				valid bool
+			}{
+				{key: 0, want: 10, valid: true},
+				{key: 30, want: 30, valid: true},
+				{key: 31, want: 40, valid: true},
+				{key: 70, want: 70, valid: true},
+				{key: 71, want: 0, valid: false},
+			}
+			it := tree.Iterator()
+			for _, tt := range tests {
+				if got := it.Seek(tt.key); got != tt.valid || it.Valid() != tt.valid || it.Key() != tt.want {
+					t1.Errorf("Seek(%v) = %v, Key() = %v, want %v, %v", tt.key, got, it.Key(), tt.valid, tt.want)
+				}
+			}
+
+			it.Seek(35)
+			var got []int
+			for it.Prev() {
+				got = append(got, it.Key())
+			}
+			if want := []int{30, 20, 10}; !reflect.DeepEqual(got, want) {
+				t1.Errorf("Prev() after Seek(35) visited %v, want %v", got, want)
+			}
+		})
+
+		t1.Run("first and last", func(t1 *testing.T) {
+			it := tree.Iterator()
+			if !it.Last() || it.Key() != 70 {
+				t1.Errorf("Last() Key() = %v, want 70", it.Key())
+			}
+			if it.Next() || it.Valid() {
+				t1.Errorf("Next() after Last() = true, want false")
+			}
+			if it.Prev() {
+				t1.Errorf("Prev() on exhausted iterator = true, want false")
+			}
+			if !it.First() || it.Key() != 10 {
+				t1.Errorf("First() Key() = %v, want 10", it.Key())
+			}
+			if !it.Next() || it.Key() != 20 {
+				t1.Errorf("Next() after First() Key() = %v, want 20", it.Key())
+			}
+		})
+	}
+}
+
+func TestIterator_EmptyTree(t1 *testing.T) {
+	it := New[string, int]().Iterator()
+	if it.Next() || it.Prev() || it.First() || it.Last() || it.Seek("a") || it.Valid() {
+		t1.Errorf("iterator over empty tree moved to an element")
+	}
+	if it.Key() != "" || it.Value() != 0 {
+		t1.Errorf("Key(), Value() = %q, %v, want zero values", it.Key(), it.Value())
+	}
+}
@@ -0,0 +1,87 @@
+package tree
+
+// MorrisTreeWalk is a function for getting ordered array of tree's elements with O(1) extra memory.
+// - param d sets the order of keys (Asc or Desc)
+func (t *Tree[K, V]) MorrisTreeWalk(d direction) []K {
+	var result []K
+	t.MorrisWalk(d, func(key K, _ V) bool {
+		result = append(result, key)
+		return true
+	})
+
+	return result
+}
+
+// MorrisWalk is a function for visiting elements in sorted order with O(1) extra memory (Morris traversal).
+// Instead of a stack it temporarily threads the last node of every left subtree to its ancestor
+// (by right pointer for Asc and by left pointer for Desc). The tree is restored when the walk ends,
+// even if fn returns false or panics. The tree must not be read or modified by fn.
+// - param d sets the order of elements (Asc or Desc)
+// - param fn is called for every element, the walk stops when it returns false
+func (t *Tree[K, V]) MorrisWalk(d direction, fn func(key K, value V) bool) {
+	// visiting is the node passed to fn which hasn't returned true yet
+	var visiting *node[K, V]
+	defer func() {
+		if visiting != nil {
+			unthread(visiting, d)
+		}
+	}()
+
+	curr := t.root
+	for curr != nil {
+		first, second := links(curr, d)
+		if *first != nil {
+			pred := lastThreaded(*first, curr, d)
+			_, thread := links(pred, d)
+			if *thread == nil {
+				*thread = curr
+				curr = *first
+				continue
+			}
+			*thread = nil
+		}
+
+		visiting = curr
+		if !fn(curr.element.key, curr.element.value) {
+			return
+		}
+		visiting = nil
+		curr = *second
+	}
+}
+
+// links returns pointers to the child fields of the node in order of visiting: left first for Asc, right first for Desc.
+func links[K, V any](n *node[K, V], d direction) (**node[K, V], **node[K, V]) {
+	if d == Desc {
+		return &n.right, &n.left
+	}
+
+	return &n.left, &n.right
+}
+
+// lastThreaded returns the last node of the subtree n in walk order,
+// which is either a leaf of the subtree or already threaded to ancestor.
+func lastThreaded[K, V any](n, ancestor *node[K, V], d direction) *node[K, V] {
+	for {
+		_, second := links(n, d)
+		if *second == nil || *second == ancestor {
+			return n
+		}
+		n = *second
+	}
+}
+
+// unthread removes threads left by the Morris traversal which stopped at node n.
+// Threads exist only to the ancestors of n whose first subtree contains n, so they are found by parent pointers.
+func unthread[K, V any](n *node[K, V], d direction) {
+	for c, p := n, n.parent; p != nil; c, p = p, p.parent {
+		if first, _ := links(p, d); *first != c {
+			continue
+		}
+
+		pred := lastThreaded(c, p, d)
+		if _, thread := links(pred, d); *thread == p {
+			*thread = nil
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package tree
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTree_MorrisTreeWalk(t1 *testing.T) {
+	for _, opts := range [][]Option{nil, {WithAVL()}, {WithRedBlack()}} {
+		tree := New[int, int](opts...)
+		for _, key := range []int{50, 30, 70, 20, 40, 60, 80, 35, 45, 10} {
+			tree.Insert(key, key)
+		}
+
+		if got, want := tree.MorrisTreeWalk(Asc), tree.InOrderTreeWalk(Asc); !reflect.DeepEqual(got, want) {
+			t1.Errorf("MorrisTreeWalk(Asc) = %v, want %v", got, want)
+		}
+		if got, want := tree.MorrisTreeWalk(Desc), tree.InOrderTreeWalk(Desc); !reflect.DeepEqual(got, want) {
+			t1.Errorf("MorrisTreeWalk(Desc) = %v, want %v", got, want)
+		}
+	}
+
+	if got := New[int, int]().MorrisTreeWalk(Asc); got != nil {
+		t1.Errorf("MorrisTreeWalk() of empty tree = %v, want nil", got)
+	}
+}
+
+func TestTree_MorrisWalkRestoresTree(t1 *testing.T) {
+	build := func() *Tree[int, int] {
+		tree := New[int, int]()
+		for _, key := range []int{50, 30, 70, 20, 40, 60, 80, 35, 45, 10, 65, 75, 90} {
+			tree.Insert(key, key)
+		}
+		return tree
+	}
+	want := build()
+
+	for _, d := range []direction{Asc, Desc} {
+		for stop := 1; stop <= want.Len(); stop++ {
+			tree := build()
+			visited := 0
+			tree.MorrisWalk(d, func(key, value int) bool {
+				visited++
+				return visited < stop
+			})
+			if visited != stop {
+				t1.Errorf("MorrisWalk(%v) visited %d elements, want %d", d, visited, stop)
+			}
+			if !reflect.DeepEqual(tree.root, want.root) {
+				t1.Fatalf("MorrisWalk(%v) stopped after %d elements left the tree changed", d, stop)
+			}
+		}
+	}
+
+	t1.Run("panic in callback", func(t1 *testing.T) {
+		tree := build()
+		func() {
+			defer func() {
+				if recover() == nil {
+					t1.Errorf("MorrisWalk() didn't propagate panic")
+				}
+			}()
+			tree.MorrisWalk(Asc, func(key, value int) bool {
+				if key == 40 {
+					panic("stop")
+				}
+				return true
+			})
+		}()
+		if !reflect.DeepEqual(tree.root, want.root) {
+			t1.Errorf("MorrisWalk() left the tree changed after panic")
+		}
+	})
+}
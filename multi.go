@@ -0,0 +1,211 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// MultiTree is the structure of binary search tree which keeps duplicate keys (multimap).
+// Values of equal keys are kept in insertion order, every walk visits them in this order.
+type MultiTree[K, V any] struct {
+	tree *Tree[K, []V]
+	len  int
+}
+
+// NewMulti is a function for creation empty tree with duplicate keys
+// - type param K (key) should be `ordered type` (`int`, `string`, `float` etc)
+// - type param V (value) can be any type
+// - opts can be used for choosing self-balancing variant of the tree (WithAVL, WithRedBlack)
+func NewMulti[K cmp.Ordered, V any](opts ...Option) *MultiTree[K, V] {
+	return NewMultiWithComparator[K, V](cmp.Compare[K], opts...)
+}
+
+// NewMultiWithComparator is a function for creation empty tree with duplicate keys and custom order of keys
+// - type param K (key) can be any type
+// - param cmp should return a negative number when a < b, zero when a == b and a positive number when a > b
+// - opts can be used for choosing self-balancing variant of the tree (WithAVL, WithRedBlack)
+func NewMultiWithComparator[K, V any](cmp func(a, b K) int, opts ...Option) *MultiTree[K, V] {
+	return &MultiTree[K, V]{tree: NewWithComparator[K, []V](cmp, opts...)}
+}
+
+// Insert is a function for inserting element into tree.
+// If elements with the key already exist, the value is put after them.
+// - param value can be any type
+func (m *MultiTree[K, V]) Insert(key K, value V) {
+	m.len++
+	n, parent, place := m.tree.locate(key)
+	if n != nil {
+		n.element.value = append(n.element.value, value)
+		return
+	}
+
+	m.tree.attach(key, []V{value}, parent, place)
+}
+
+// GetValue is a function for getting the first inserted value of the key
+// - second result is false if element with the key doesn't exist
+func (m *MultiTree[K, V]) GetValue(key K) (V, bool) {
+	var result V
+	n := search(m.tree.root, key, m.tree.cmp)
+	if n == nil {
+		return result, false
+	}
+
+	return n.element.value[0], true
+}
+
+// GetAll is a function for getting all values of the key in insertion order.
+// The result is a copy, so it can be changed without changing the tree.
+func (m *MultiTree[K, V]) GetAll(key K) []V {
+	n := search(m.tree.root, key, m.tree.cmp)
+	if n == nil {
+		return nil
+	}
+
+	return slices.Clone(n.element.value)
+}
+
+// Count is a function for getting the number of elements with the key.
+func (m *MultiTree[K, V]) Count(key K) int {
+	n := search(m.tree.root, key, m.tree.cmp)
+	if n == nil {
+		return 0
+	}
+
+	return len(n.element.value)
+}
+
+// Exists is a function for checking that at least one element with the key exists.
+func (m *MultiTree[K, V]) Exists(key K) bool {
+	return m.tree.Exists(key)
+}
+
+// Delete is a function for deleting the first inserted element with the key, like DeleteOne.
+// - returns the deleted value and false if element with the key doesn't exist
+func (m *MultiTree[K, V]) Delete(key K) (V, bool) {
+	var result V
+	n := search(m.tree.root, key, m.tree.cmp)
+	if n == nil {
+		return result, false
+	}
+
+	result = n.element.value[0]
+	m.len--
+	if len(n.element.value) == 1 {
+		m.tree.deleteNode(n)
+		return result, true
+	}
+
+	n.element.value = slices.Delete(n.element.value, 0, 1)
+	return result, true
+}
+
+// DeleteOne is a function for deleting the first inserted element with the key.
+// - returns false if element with the key doesn't exist
+func (m *MultiTree[K, V]) DeleteOne(key K) bool {
+	_, ok := m.Delete(key)
+
+	return ok
+}
+
+// DeleteAll is a function for deleting all elements with the key.
+// - returns the number of deleted elements
+func (m *MultiTree[K, V]) DeleteAll(key K) int {
+	n := search(m.tree.root, key, m.tree.cmp)
+	if n == nil {
+		return 0
+	}
+
+	count := len(n.element.value)
+	m.len -= count
+	m.tree.deleteNode(n)
+	return count
+}
+
+// Validate is a function for checking the structure of the tree, like Tree.Validate.
+// It also checks that every key has at least one value and the number of elements.
+func (m *MultiTree[K, V]) Validate() error {
+	if err := m.tree.Validate(); err != nil {
+		return err
+	}
+
+	count := 0
+	for n := min(m.tree.root); n != nil; n = next(n) {
+		if len(n.element.value) == 0 {
+			return invalid(n, "key has no values")
+		}
+		count += len(n.element.value)
+	}
+	if count != m.len {
+		return fmt.Errorf("%w: cached number of elements %d, want %d", ErrInvalidTree, m.len, count)
+	}
+
+	return nil
+}
+
+// Len is a function for getting the number of tree's elements (every duplicate is counted).
+func (m *MultiTree[K, V]) Len() int {
+	return m.len
+}
+
+// KeysLen is a function for getting the number of distinct keys.
+func (m *MultiTree[K, V]) KeysLen() int {
+	return m.tree.Len()
+}
+
+// IsEmpty is a function for checking that the tree has no elements.
+func (m *MultiTree[K, V]) IsEmpty() bool {
+	return m.tree.IsEmpty()
+}
+
+// Clear is a function for deleting all elements from the tree.
+func (m *MultiTree[K, V]) Clear() {
+	m.tree.Clear()
+	m.len = 0
+}
+
+// Min is a function for searching min element in tree (by key).
+func (m *MultiTree[K, V]) Min() K {
+	return m.tree.Min()
+}
+
+// Max is a function for searching max element in tree (by key).
+func (m *MultiTree[K, V]) Max() K {
+	return m.tree.Max()
+}
+
+// InOrderTreeWalk is a function for getting ordered array of tree's keys, every duplicate is repeated.
+func (m *MultiTree[K, V]) InOrderTreeWalk(d direction) []K {
+	var result []K
+	m.Walk(d, func(key K, _ V) bool {
+		result = append(result, key)
+		return true
+	})
+
+	return result
+}
+
+// Walk is a function for visiting elements in sorted order of keys.
+// Values of equal keys are visited in insertion order for both directions.
+// - param d sets the order of keys (Asc or Desc)
+// - param fn is called for every element, the walk stops when it returns false
+func (m *MultiTree[K, V]) Walk(d direction, fn func(key K, value V) bool) {
+	m.tree.Walk(d, func(key K, values []V) bool {
+		for _, value := range values {
+			if !fn(key, value) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// All is a function for iterating over tree's elements in ascending order of keys,
+// values of equal keys are visited in insertion order.
+func (m *MultiTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.Walk(Asc, yield)
+	}
+}
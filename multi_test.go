@@ -0,0 +1,103 @@
+package tree
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMultiTree(t1 *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{name: "plain"},
+		{name: "avl", opts: []Option{WithAVL()}},
+		{name: "red-black", opts: []Option{WithRedBlack()}},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			m := NewMulti[int, string](tt.opts...)
+			for _, e := range []Entry[int, string]{{5, "a"}, {3, "b"}, {5, "c"}, {7, "d"}, {5, "e"}, {3, "f"}} {
+				m.Insert(e.Key, e.Value)
+			}
+
+			if got := m.Len(); got != 6 {
+				t1.Errorf("Len() = %v, want 6", got)
+			}
+			if got := m.KeysLen(); got != 3 {
+				t1.Errorf("KeysLen() = %v, want 3", got)
+			}
+			if got := m.Count(5); got != 3 {
+				t1.Errorf("Count(5) = %v, want 3", got)
+			}
+			if got, want := m.GetAll(5), []string{"a", "c", "e"}; !reflect.DeepEqual(got, want) {
+				t1.Errorf("GetAll(5) = %v, want %v", got, want)
+			}
+			if got, ok := m.GetValue(3); !ok || got != "b" {
+				t1.Errorf("GetValue(3) = %v, %v, want b, true", got, ok)
+			}
+			if got, want := m.InOrderTreeWalk(Asc), []int{3, 3, 5, 5, 5, 7}; !reflect.DeepEqual(got, want) {
+				t1.Errorf("InOrderTreeWalk(Asc) = %v, want %v", got, want)
+			}
+
+			var values []string
+			m.Walk(Desc, func(_ int, value string) bool {
+				values = append(values, value)
+				return true
+			})
+			if want := []string{"d", "a", "c", "e", "b", "f"}; !reflect.DeepEqual(values, want) {
+				t1.Errorf("Walk(Desc) values = %v, want %v", values, want)
+			}
+
+			if got, ok := m.Delete(5); !ok || got != "a" {
+				t1.Errorf("Delete(5) = %v, %v, want a, true", got, ok)
+			}
+			if got, want := m.GetAll(5), []string{"c", "e"}; !reflect.DeepEqual(got, want) {
+				t1.Errorf("GetAll(5) after Delete = %v, want %v", got, want)
+			}
+			if !m.DeleteOne(7) || m.Exists(7) {
+				t1.Errorf("DeleteOne(7) left Exists(7) = %v", m.Exists(7))
+			}
+			if m.DeleteOne(7) {
+				t1.Errorf("DeleteOne(7) of missing key = true, want false")
+			}
+			if got := m.DeleteAll(5); got != 2 {
+				t1.Errorf("DeleteAll(5) = %v, want 2", got)
+			}
+			if got := m.DeleteAll(5); got != 0 {
+				t1.Errorf("DeleteAll(5) of missing key = %v, want 0", got)
+			}
+			if got := m.Len(); got != 2 {
+				t1.Errorf("Len() = %v, want 2", got)
+			}
+
+			var all []Entry[int, string]
+			for key, value := range m.All() {
+				all = append(all, Entry[int, string]{key, value})
+			}
+			if want := []Entry[int, string]{{3, "b"}, {3, "f"}}; !reflect.DeepEqual(all, want) {
+				t1.Errorf("All() = %v, want %v", all, want)
+			}
+
+			m.Clear()
+			if !m.IsEmpty() || m.Len() != 0 || m.Count(3) != 0 {
+				t1.Errorf("after Clear() IsEmpty() = %v, Len() = %v", m.IsEmpty(), m.Len())
+			}
+		})
+	}
+}
+
+func TestMultiTree_GetAllReturnsCopy(t1 *testing.T) {
+	m := NewMultiWithComparator[int, int](func(a, b int) int { return b - a })
+	m.Insert(1, 1)
+	m.Insert(1, 2)
+	m.Insert(2, 3)
+
+	m.GetAll(1)[0] = 100
+	if got, want := m.GetAll(1), []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t1.Errorf("GetAll(1) = %v, want %v", got, want)
+	}
+	if got := m.Min(); got != 2 {
+		t1.Errorf("Min() = %v, want 2", got)
+	}
+}
@@ -0,0 +1,70 @@
+package tree
+
+// Floor is a function for searching element with the greatest key less than or equal to the key.
+// - third result is false if there is no such element
+func (t *Tree[K, V]) Floor(key K) (K, V, bool) {
+	return unpack(t.floor(key, false))
+}
+
+// Lower is a function for searching element with the greatest key strictly less than the key.
+// - third result is false if there is no such element
+func (t *Tree[K, V]) Lower(key K) (K, V, bool) {
+	return unpack(t.floor(key, true))
+}
+
+// Ceiling is a function for searching element with the smallest key greater than or equal to the key.
+// - third result is false if there is no such element
+func (t *Tree[K, V]) Ceiling(key K) (K, V, bool) {
+	return unpack(t.ceiling(key, false))
+}
+
+// Higher is a function for searching element with the smallest key strictly greater than the key.
+// - third result is false if there is no such element
+func (t *Tree[K, V]) Higher(key K) (K, V, bool) {
+	return unpack(t.ceiling(key, true))
+}
+
+// floor finds the node with the greatest key <= key (< key if strict) by one root-to-leaf descent.
+func (t *Tree[K, V]) floor(key K, strict bool) *node[K, V] {
+	var result *node[K, V]
+	n := t.root
+	for n != nil {
+		c := t.cmp(key, n.element.key)
+		if c > 0 || c == 0 && !strict {
+			result = n
+			n = n.right
+			continue
+		}
+		n = n.left
+	}
+
+	return result
+}
+
+// ceiling finds the node with the smallest key >= key (> key if strict) by one root-to-leaf descent.
+func (t *Tree[K, V]) ceiling(key K, strict bool) *node[K, V] {
+	var result *node[K, V]
+	n := t.root
+	for n != nil {
+		c := t.cmp(key, n.element.key)
+		if c < 0 || c == 0 && !strict {
+			result = n
+			n = n.left
+			continue
+		}
+		n = n.right
+	}
+
+	return result
+}
+
+// unpack returns key and value of the node and false if the node is nil.
+func unpack[K, V any](n *node[K, V]) (K, V, bool) {
+	if n == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+
+	return n.element.key, n.element.value, true
+}
@@ -0,0 +1,110 @@
+package tree
+
+import "testing"
+
+func TestTree_FloorCeiling(t1 *testing.T) {
+	type want struct {
+		key int
+		ok  bool
+	}
+	type testCase struct {
+		name    string
+		key     int
+		floor   want
+		lower   want
+		ceiling want
+		higher  want
+	}
+
+	tests := []testCase{
+		{
+			name:    "less than min",
+			key:     5,
+			floor:   want{ok: false},
+			lower:   want{ok: false},
+			ceiling: want{key: 10, ok: true},
+			higher:  want{key: 10, ok: true},
+		},
+		{
+			name:    "equal to min",
+			key:     10,
+			floor:   want{key: 10, ok: true},
+			lower:   want{ok: false},
+			ceiling: want{key: 10, ok: true},
+			higher:  want{key: 20, ok: true},
+		},
+		{
+			name:    "between keys",
+			key:     25,
+			floor:   want{key: 20, ok: true},
+			lower:   want{key: 20, ok: true},
+			ceiling: want{key: 30, ok: true},
+			higher:  want{key: 30, ok: true},
+		},
+		{
+			name:    "existing key",
+			key:     40,
+			floor:   want{key: 40, ok: true},
+			lower:   want{key: 30, ok: true},
+			ceiling: want{key: 40, ok: true},
+			higher:  want{key: 50, ok: true},
+		},
+		{
+			name:    "equal to max",
+			key:     70,
+			floor:   want{key: 70, ok: true},
+			lower:   want{key: 60, ok: true},
+			ceiling: want{key: 70, ok: true},
+			higher:  want{ok: false},
+		},
+		{
+			name:    "greater than max",
+			key:     75,
+			floor:   want{key: 70, ok: true},
+			lower:   want{key: 70, ok: true},
+			ceiling: want{ok: false},
+			higher:  want{ok: false},
+		},
+	}
+
+	for _, opts := range [][]Option{nil, {WithAVL()}, {WithRedBlack()}} {
+		tree := New[int, int](opts...)
+		for _, key := range []int{40, 20, 60, 10, 30, 50, 70} {
+			tree.Insert(key, key*10)
+		}
+
+		for _, tt := range tests {
+			t1.Run(tt.name, func(t1 *testing.T) {
+				check := func(name string, key int, value int, ok bool, w want) {
+					t1.Helper()
+					if ok != w.ok || key != w.key {
+						t1.Errorf("%s(%v) = %v, %v, want %v, %v", name, tt.key, key, ok, w.key, w.ok)
+						return
+					}
+					if ok && value != key*10 {
+						t1.Errorf("%s(%v) value = %v, want %v", name, tt.key, value, key*10)
+					}
+				}
+
+				key, value, ok := tree.Floor(tt.key)
+				check("Floor", key, value, ok, tt.floor)
+				key, value, ok = tree.Lower(tt.key)
+				check("Lower", key, value, ok, tt.lower)
+				key, value, ok = tree.Ceiling(tt.key)
+				check("Ceiling", key, value, ok, tt.ceiling)
+				key, value, ok = tree.Higher(tt.key)
+				check("Higher", key, value, ok, tt.higher)
+			})
+		}
+	}
+}
+
+func TestTree_FloorCeilingEmpty(t1 *testing.T) {
+	tree := New[int, int]()
+	if _, _, ok := tree.Floor(1); ok {
+		t1.Errorf("Floor() ok = true, want false")
+	}
+	if _, _, ok := tree.Ceiling(1); ok {
+		t1.Errorf("Ceiling() ok = true, want false")
+	}
+}
@@ -1,58 +1,91 @@
 package tree
 
-import "golang.org/x/exp/constraints"
-
-type element[V constraints.Ordered] struct {
-	key   V
-	value any
+type element[K, V any] struct {
+	key   K
+	value V
 }
 
 // node is the structure of tree's node.
-// node's key is any ordered type for type of
-// node's value has type any
-type node[V constraints.Ordered] struct {
-	element element[V]
-	parent  *node[V]
-	left    *node[V]
-	right   *node[V]
+// node's key has type K, keys are ordered by the tree's comparator
+// node's value has type V (the second type param of the tree)
+type node[K, V any] struct {
+	element element[K, V]
+	parent  *node[K, V]
+	left    *node[K, V]
+	right   *node[K, V]
+	height  int
+	size    int
+	color   color
 }
 
-func (n *node[V]) hasNoChildren() bool {
+func (n *node[K, V]) hasNoChildren() bool {
 	return n.left == nil && n.right == nil
 }
 
-func (n *node[V]) insertNode(newNode *node[V]) {
-	if newNode.element.key < n.element.key {
+// update recalculates cached height and size of the node's subtree from its children.
+func (n *node[K, V]) update() {
+	n.size = size(n.left) + 1 + size(n.right)
+
+	l, r := height(n.left), height(n.right)
+	if l > r {
+		n.height = l + 1
+		return
+	}
+
+	n.height = r + 1
+}
+
+// balanceFactor is the difference between heights of left and right subtrees.
+func (n *node[K, V]) balanceFactor() int {
+	return height(n.left) - height(n.right)
+}
+
+// insertNode puts newNode as a leaf of the subtree.
+// If the subtree already has node with the same key, newNode isn't inserted and the existing node is returned.
+func (n *node[K, V]) insertNode(newNode *node[K, V], cmp func(a, b K) int) *node[K, V] {
+	c := cmp(newNode.element.key, n.element.key)
+	if c == 0 {
+		return n
+	}
+
+	if c < 0 {
 		if n.left == nil {
-			addLeaf[V](newNode, n, &n.left)
-			return
+			addLeaf[K, V](newNode, n, &n.left)
+			return nil
 		}
-		n.left.insertNode(newNode)
-		return
+		return n.left.insertNode(newNode, cmp)
 	}
 
 	if n.right == nil {
-		addLeaf[V](newNode, n, &n.right)
-		return
+		addLeaf[K, V](newNode, n, &n.right)
+		return nil
 	}
 
-	n.right.insertNode(newNode)
+	return n.right.insertNode(newNode, cmp)
+}
+
+// replaceValue puts the value to the node and returns the previous one.
+func (n *node[K, V]) replaceValue(value V) (V, bool) {
+	old := n.element.value
+	n.element.value = value
+
+	return old, true
 }
 
-func addLeaf[V constraints.Ordered](newNode, parentNode *node[V], nodePlace **node[V]) {
+func addLeaf[K, V any](newNode, parentNode *node[K, V], nodePlace **node[K, V]) {
 	*nodePlace = newNode
 	newNode.parent = parentNode
 }
 
-func inOrderTreeWalk[V constraints.Ordered](n *node[V], d direction) []V {
+func inOrderTreeWalk[K, V any](n *node[K, V], d direction) []K {
 	if n == nil {
-		return []V{}
+		return []K{}
 	}
 
 	left := inOrderTreeWalk(n.left, d)
 	right := inOrderTreeWalk(n.right, d)
 
-	output := make([]V, 0)
+	output := make([]K, 0)
 	if d == Asc {
 		return append(output, append(append(left, n.element.key), right...)...)
 	}
@@ -60,9 +93,30 @@ func inOrderTreeWalk[V constraints.Ordered](n *node[V], d direction) []V {
 	return append(output, append(append(right, n.element.key), left...)...)
 }
 
-func search[V constraints.Ordered](n *node[V], key V) *node[V] {
-	for n != nil && key != n.element.key {
-		if key < n.element.key {
+func height[K, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+
+	return n.height
+}
+
+func size[K, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+
+	return n.size
+}
+
+func search[K, V any](n *node[K, V], key K, cmp func(a, b K) int) *node[K, V] {
+	for n != nil {
+		c := cmp(key, n.element.key)
+		if c == 0 {
+			return n
+		}
+
+		if c < 0 {
 			n = n.left
 			continue
 		}
@@ -72,7 +126,7 @@ func search[V constraints.Ordered](n *node[V], key V) *node[V] {
 	return n
 }
 
-func min[V constraints.Ordered](n *node[V]) *node[V] {
+func min[K, V any](n *node[K, V]) *node[K, V] {
 	if n == nil {
 		return nil
 	}
@@ -83,3 +137,85 @@ func min[V constraints.Ordered](n *node[V]) *node[V] {
 
 	return n
 }
+
+func max[K, V any](n *node[K, V]) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+
+	for n.right != nil {
+		n = n.right
+	}
+
+	return n
+}
+
+// next returns the node which follows n in sorted order (nil if n is the last one).
+func next[K, V any](n *node[K, V]) *node[K, V] {
+	if n.right != nil {
+		return min(n.right)
+	}
+
+	p := n.parent
+	for p != nil && n == p.right {
+		n, p = p, p.parent
+	}
+
+	return p
+}
+
+// prev returns the node which precedes n in sorted order (nil if n is the first one).
+func prev[K, V any](n *node[K, V]) *node[K, V] {
+	if n.left != nil {
+		return max(n.left)
+	}
+
+	p := n.parent
+	for p != nil && n == p.left {
+		n, p = p, p.parent
+	}
+
+	return p
+}
+
+// preOrderNext returns the node which follows n in preOrder traversal.
+func preOrderNext[K, V any](n *node[K, V]) *node[K, V] {
+	if n.left != nil {
+		return n.left
+	}
+	if n.right != nil {
+		return n.right
+	}
+
+	for p := n.parent; p != nil; n, p = p, p.parent {
+		if n == p.left && p.right != nil {
+			return p.right
+		}
+	}
+
+	return nil
+}
+
+// postOrderNext returns the node which follows n in postOrder traversal.
+func postOrderNext[K, V any](n *node[K, V]) *node[K, V] {
+	p := n.parent
+	if p == nil || n == p.right || p.right == nil {
+		return p
+	}
+
+	return postOrderFirst(p.right)
+}
+
+// postOrderFirst returns the first node of postOrder traversal of the subtree (its leftmost leaf).
+func postOrderFirst[K, V any](n *node[K, V]) *node[K, V] {
+	for {
+		switch {
+		case n.left != nil:
+			n = n.left
+		case n.right != nil:
+			n = n.right
+		default:
+			return n
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package tree
+
+// Rank is a function for counting keys which are less than the key.
+// It works in O(height) using subtree sizes stored in nodes.
+// - param key can be absent in the tree
+func (t *Tree[K, V]) Rank(key K) int {
+	rank := 0
+	n := t.root
+	for n != nil {
+		if t.cmp(key, n.element.key) <= 0 {
+			n = n.left
+			continue
+		}
+
+		rank += size(n.left) + 1
+		n = n.right
+	}
+
+	return rank
+}
+
+// Select is a function for getting i-th smallest element of the tree (starting from 0).
+// It works in O(height) using subtree sizes stored in nodes.
+// - third result is false if i is out of range [0, Len())
+func (t *Tree[K, V]) Select(i int) (K, V, bool) {
+	n := t.root
+	for n != nil {
+		l := size(n.left)
+		if i == l {
+			break
+		}
+
+		if i < l {
+			n = n.left
+			continue
+		}
+		i -= l + 1
+		n = n.right
+	}
+
+	return unpack(n)
+}
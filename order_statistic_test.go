@@ -0,0 +1,113 @@
+package tree
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// checkSizes verifies cached subtree sizes and returns the size of the subtree.
+func checkSizes[K, V any](t *testing.T, n *node[K, V]) int {
+	t.Helper()
+	if n == nil {
+		return 0
+	}
+
+	s := checkSizes(t, n.left) + 1 + checkSizes(t, n.right)
+	if n.size != s {
+		t.Fatalf("node %v: cached size = %d, want %d", n.element.key, n.size, s)
+	}
+
+	return s
+}
+
+func TestTree_RankSelect(t1 *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{name: "plain"},
+		{name: "avl", opts: []Option{WithAVL()}},
+		{name: "red-black", opts: []Option{WithRedBlack()}},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			r := rand.New(rand.NewSource(7))
+			tree := New[int, int](tt.opts...)
+			keys := map[int]bool{}
+			for i := 0; i < 300; i++ {
+				key := r.Intn(1000) * 2
+				if keys[key] {
+					continue
+				}
+				keys[key] = true
+				if i%2 == 0 {
+					tree.Insert(key, -key)
+					continue
+				}
+				tree.InsertWithoutRecursion(key, -key)
+			}
+			for key := range keys {
+				if r.Intn(3) == 0 {
+					tree.Delete(key)
+					delete(keys, key)
+				}
+			}
+			checkSizes(t1, tree.root)
+
+			var sorted []int
+			for key := range keys {
+				sorted = append(sorted, key)
+			}
+			sort.Ints(sorted)
+
+			for i, key := range sorted {
+				gotKey, gotValue, ok := tree.Select(i)
+				if !ok || gotKey != key || gotValue != -key {
+					t1.Fatalf("Select(%d) = %v, %v, %v, want %v, %v, true", i, gotKey, gotValue, ok, key, -key)
+				}
+				if got := tree.Rank(key); got != i {
+					t1.Fatalf("Rank(%v) = %v, want %v", key, got, i)
+				}
+				// odd keys are absent: the rank is the number of keys before them
+				if got := tree.Rank(key + 1); got != i+1 {
+					t1.Fatalf("Rank(%v) = %v, want %v", key+1, got, i+1)
+				}
+			}
+
+			if _, _, ok := tree.Select(-1); ok {
+				t1.Errorf("Select(-1) ok = true, want false")
+			}
+			if _, _, ok := tree.Select(len(sorted)); ok {
+				t1.Errorf("Select(%d) ok = true, want false", len(sorted))
+			}
+			if got := tree.Rank(-1); got != 0 {
+				t1.Errorf("Rank(-1) = %v, want 0", got)
+			}
+		})
+	}
+}
+
+func TestTree_RankWithRepeatedInserts(t1 *testing.T) {
+	tree := New[int, int](WithRedBlack())
+	for _, key := range []int{5, 3, 5, 5, 1, 7, 5} {
+		tree.Insert(key, key)
+	}
+
+	tests := []struct {
+		key  int
+		want int
+	}{
+		{key: 1, want: 0},
+		{key: 3, want: 1},
+		{key: 5, want: 2},
+		{key: 6, want: 3},
+		{key: 7, want: 3},
+		{key: 8, want: 4},
+	}
+	for _, tt := range tests {
+		if got := tree.Rank(tt.key); got != tt.want {
+			t1.Errorf("Rank(%v) = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package tree
+
+import "iter"
+
+// OrderedMap is the interface of key-value storages which keep their keys ordered.
+// Every tree variant of the package (plain, AVL and red-black) satisfies it,
+// so the implementation can be switched without changing the code which uses it.
+type OrderedMap[K, V any] interface {
+	// Insert adds element with the key and the value or replaces the value of the existing element.
+	// It returns the previous value and true if the value was replaced.
+	Insert(key K, value V) (V, bool)
+	// Delete removes element with the key.
+	// It returns the value of the removed element and false if the key doesn't exist.
+	Delete(key K) (V, bool)
+	// GetValue returns value of the element with the key.
+	GetValue(key K) (V, bool)
+	// Exists reports whether element with the key exists.
+	Exists(key K) bool
+	// Min returns the smallest key.
+	Min() K
+	// Max returns the largest key.
+	Max() K
+	// Len returns the number of elements.
+	Len() int
+	// IsEmpty reports whether there are no elements.
+	IsEmpty() bool
+	// Clear removes all elements.
+	Clear()
+	// InOrderTreeWalk returns all keys ordered by the direction.
+	InOrderTreeWalk(d direction) []K
+	// Walk visits elements in the direction until fn returns false.
+	Walk(d direction, fn func(key K, value V) bool)
+	// All iterates over elements in ascending order of keys.
+	All() iter.Seq2[K, V]
+}
+
+var _ OrderedMap[int, any] = (*Tree[int, any])(nil)
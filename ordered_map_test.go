@@ -0,0 +1,205 @@
+package tree
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// implementations is the list of every OrderedMap of the package, which must pass the conformance suite.
+var implementations = []struct {
+	name   string
+	newMap func() OrderedMap[int, int]
+}{
+	{name: "plain", newMap: func() OrderedMap[int, int] { return New[int, int]() }},
+	{name: "avl", newMap: func() OrderedMap[int, int] { return New[int, int](WithAVL()) }},
+	{name: "red-black", newMap: func() OrderedMap[int, int] { return New[int, int](WithRedBlack()) }},
+	{name: "comparator", newMap: func() OrderedMap[int, int] {
+		return NewWithComparator[int, int](func(a, b int) int { return a - b }, WithAVL())
+	}},
+}
+
+func TestOrderedMap(t *testing.T) {
+	for _, impl := range implementations {
+		t.Run(impl.name, func(t *testing.T) {
+			testOrderedMap(t, impl.newMap)
+		})
+	}
+}
+
+// testOrderedMap is the conformance suite which every OrderedMap implementation runs.
+func testOrderedMap(t *testing.T, newMap func() OrderedMap[int, int]) {
+	t.Run("empty", func(t *testing.T) {
+		m := newMap()
+		if got := m.Len(); got != 0 {
+			t.Errorf("Len() = %v, want 0", got)
+		}
+		if !m.IsEmpty() {
+			t.Errorf("IsEmpty() = false, want true")
+		}
+		if m.Exists(1) {
+			t.Errorf("Exists(1) = true, want false")
+		}
+		if _, ok := m.GetValue(1); ok {
+			t.Errorf("GetValue(1) ok = true, want false")
+		}
+		if got := m.InOrderTreeWalk(Asc); len(got) != 0 {
+			t.Errorf("InOrderTreeWalk() = %v, want empty", got)
+		}
+		m.Delete(1)
+	})
+
+	t.Run("insert and get", func(t *testing.T) {
+		m := newMap()
+		keys := rand.New(rand.NewSource(1)).Perm(200)
+		for _, key := range keys {
+			m.Insert(key, key*10)
+		}
+
+		if got := m.Len(); got != len(keys) {
+			t.Errorf("Len() = %v, want %v", got, len(keys))
+		}
+		for _, key := range keys {
+			value, ok := m.GetValue(key)
+			if !ok || value != key*10 {
+				t.Fatalf("GetValue(%v) = %v, %v, want %v, true", key, value, ok, key*10)
+			}
+			if !m.Exists(key) {
+				t.Fatalf("Exists(%v) = false, want true", key)
+			}
+		}
+		if m.Exists(len(keys)) {
+			t.Errorf("Exists(%v) = true, want false", len(keys))
+		}
+		if got := m.Min(); got != 0 {
+			t.Errorf("Min() = %v, want 0", got)
+		}
+		if got := m.Max(); got != len(keys)-1 {
+			t.Errorf("Max() = %v, want %v", got, len(keys)-1)
+		}
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		m := newMap()
+		for _, key := range []int{3, 1, 2} {
+			if _, replaced := m.Insert(key, key); replaced {
+				t.Errorf("Insert(%v) replaced = true, want false", key)
+			}
+		}
+
+		old, replaced := m.Insert(2, 20)
+		if !replaced || old != 2 {
+			t.Errorf("Insert(2) = %v, %v, want 2, true", old, replaced)
+		}
+		if value, _ := m.GetValue(2); value != 20 {
+			t.Errorf("GetValue(2) = %v, want 20", value)
+		}
+		if got := m.Len(); got != 3 {
+			t.Errorf("Len() = %v, want 3", got)
+		}
+	})
+
+	t.Run("ordered walk", func(t *testing.T) {
+		m := newMap()
+		keys := rand.New(rand.NewSource(2)).Perm(100)
+		for _, key := range keys {
+			m.Insert(key, key)
+		}
+
+		sort.Ints(keys)
+		if got := m.InOrderTreeWalk(Asc); !reflect.DeepEqual(got, keys) {
+			t.Errorf("InOrderTreeWalk(Asc) = %v, want %v", got, keys)
+		}
+
+		var got []int
+		for key, value := range m.All() {
+			if value != key {
+				t.Errorf("All() value of %v = %v, want %v", key, value, key)
+			}
+			got = append(got, key)
+		}
+		if !reflect.DeepEqual(got, keys) {
+			t.Errorf("All() = %v, want %v", got, keys)
+		}
+
+		sort.Sort(sort.Reverse(sort.IntSlice(keys)))
+		if got := m.InOrderTreeWalk(Desc); !reflect.DeepEqual(got, keys) {
+			t.Errorf("InOrderTreeWalk(Desc) = %v, want %v", got, keys)
+		}
+
+		got = nil
+		m.Walk(Desc, func(key, value int) bool {
+			got = append(got, key)
+			return len(got) < 10
+		})
+		if !reflect.DeepEqual(got, keys[:10]) {
+			t.Errorf("Walk(Desc) = %v, want %v", got, keys[:10])
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		m := newMap()
+		r := rand.New(rand.NewSource(3))
+		keys := r.Perm(300)
+		for _, key := range keys {
+			m.Insert(key, key)
+		}
+
+		left := make(map[int]bool, len(keys))
+		for _, key := range keys {
+			left[key] = true
+		}
+		for _, key := range r.Perm(len(keys))[:200] {
+			if value, ok := m.Delete(key); !ok || value != key {
+				t.Fatalf("Delete(%v) = %v, %v, want %v, true", key, value, ok, key)
+			}
+			delete(left, key)
+			if m.Exists(key) {
+				t.Fatalf("Exists(%v) = true after Delete", key)
+			}
+			if v, ok := m.(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					t.Fatalf("Validate() after Delete(%v) = %v", key, err)
+				}
+			}
+		}
+		if _, ok := m.Delete(len(keys)); ok {
+			t.Errorf("Delete(%v) of missing key ok = true, want false", len(keys))
+		}
+
+		if got := m.Len(); got != len(left) {
+			t.Errorf("Len() = %v, want %v", got, len(left))
+		}
+		for key := range left {
+			if value, ok := m.GetValue(key); !ok || value != key {
+				t.Fatalf("GetValue(%v) = %v, %v, want %v, true", key, value, ok, key)
+			}
+		}
+
+		got := m.InOrderTreeWalk(Asc)
+		if !sort.IntsAreSorted(got) || len(got) != len(left) {
+			t.Errorf("InOrderTreeWalk(Asc) = %v, want %d sorted keys", got, len(left))
+		}
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		m := newMap()
+		for i := 0; i < 10; i++ {
+			m.Insert(i, i)
+		}
+		if m.IsEmpty() {
+			t.Errorf("IsEmpty() = true, want false")
+		}
+
+		m.Clear()
+		if !m.IsEmpty() || m.Len() != 0 || m.Exists(5) {
+			t.Errorf("after Clear() IsEmpty() = %v, Len() = %v", m.IsEmpty(), m.Len())
+		}
+
+		m.Insert(1, 1)
+		if got := m.Len(); got != 1 {
+			t.Errorf("Len() after Clear() and Insert() = %v, want 1", got)
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package tree
+
+const (
+	// Closed specifies a range which includes both bounds: lo <= key <= hi.
+	Closed interval = "[]"
+	// Open specifies a range which excludes both bounds: lo < key < hi.
+	Open interval = "()"
+	// ClosedOpen specifies a range which includes only the lower bound: lo <= key < hi.
+	ClosedOpen interval = "[)"
+	// OpenClosed specifies a range which includes only the upper bound: lo < key <= hi.
+	OpenClosed interval = "(]"
+)
+
+// interval is a type which uses to set inclusivity of range bounds (Closed, Open, ClosedOpen or OpenClosed).
+type interval string
+
+func (i interval) loOpen() bool {
+	return i == Open || i == OpenClosed
+}
+
+func (i interval) hiOpen() bool {
+	return i == Open || i == ClosedOpen
+}
+
+// Entry is the structure of tree's element (key and value).
+type Entry[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// Range is a function for getting elements with keys between lo and hi.
+// Subtrees outside the bounds are not visited.
+// - param i sets which bounds are included (Closed, Open, ClosedOpen or OpenClosed)
+// - param d sets the order of elements (Asc or Desc)
+func (t *Tree[K, V]) Range(lo, hi K, i interval, d direction) []Entry[K, V] {
+	var result []Entry[K, V]
+	t.RangeFunc(lo, hi, i, d, func(key K, value V) bool {
+		result = append(result, Entry[K, V]{Key: key, Value: value})
+		return true
+	})
+
+	return result
+}
+
+// RangeFunc is a function for visiting elements with keys between lo and hi.
+// Subtrees outside the bounds are not visited.
+// - param i sets which bounds are included (Closed, Open, ClosedOpen or OpenClosed)
+// - param d sets the order of elements (Asc or Desc)
+// - param fn is called for every element, the walk stops when it returns false
+func (t *Tree[K, V]) RangeFunc(lo, hi K, i interval, d direction, fn func(key K, value V) bool) {
+	if d == Asc {
+		for n := t.ceiling(lo, i.loOpen()); n != nil && t.belowHi(n.element.key, hi, i); n = next(n) {
+			if !fn(n.element.key, n.element.value) {
+				return
+			}
+		}
+		return
+	}
+
+	for n := t.floor(hi, i.hiOpen()); n != nil && t.aboveLo(n.element.key, lo, i); n = prev(n) {
+		if !fn(n.element.key, n.element.value) {
+			return
+		}
+	}
+}
+
+// aboveLo reports whether key satisfies the lower bound of the range.
+func (t *Tree[K, V]) aboveLo(key, lo K, i interval) bool {
+	c := t.cmp(key, lo)
+	return c > 0 || c == 0 && !i.loOpen()
+}
+
+// belowHi reports whether key satisfies the upper bound of the range.
+func (t *Tree[K, V]) belowHi(key, hi K, i interval) bool {
+	c := t.cmp(key, hi)
+	return c < 0 || c == 0 && !i.hiOpen()
+}
@@ -0,0 +1,111 @@
+package tree
+
+import (
+	"cmp"
+	"reflect"
+	"testing"
+)
+
+func TestTree_Range(t1 *testing.T) {
+	type args struct {
+		lo, hi int
+		i      interval
+		d      direction
+	}
+	type testCase struct {
+		name string
+		args args
+		want []int
+	}
+
+	tests := []testCase{
+		{name: "closed", args: args{lo: 20, hi: 50, i: Closed, d: Asc}, want: []int{20, 30, 40, 50}},
+		{name: "open", args: args{lo: 20, hi: 50, i: Open, d: Asc}, want: []int{30, 40}},
+		{name: "closed open", args: args{lo: 20, hi: 50, i: ClosedOpen, d: Asc}, want: []int{20, 30, 40}},
+		{name: "open closed", args: args{lo: 20, hi: 50, i: OpenClosed, d: Asc}, want: []int{30, 40, 50}},
+		{name: "closed - desc", args: args{lo: 20, hi: 50, i: Closed, d: Desc}, want: []int{50, 40, 30, 20}},
+		{name: "closed open - desc", args: args{lo: 20, hi: 50, i: ClosedOpen, d: Desc}, want: []int{40, 30, 20}},
+		{name: "open closed - desc", args: args{lo: 20, hi: 50, i: OpenClosed, d: Desc}, want: []int{50, 40, 30}},
+		{name: "bounds between keys", args: args{lo: 15, hi: 45, i: Open, d: Asc}, want: []int{20, 30, 40}},
+		{name: "whole tree", args: args{lo: 0, hi: 100, i: Closed, d: Asc}, want: []int{10, 20, 30, 40, 50, 60, 70}},
+		{name: "empty range", args: args{lo: 31, hi: 39, i: Closed, d: Asc}, want: nil},
+		{name: "lo greater than hi", args: args{lo: 50, hi: 20, i: Closed, d: Desc}, want: nil},
+		{name: "single point", args: args{lo: 40, hi: 40, i: Closed, d: Asc}, want: []int{40}},
+		{name: "single point - open", args: args{lo: 40, hi: 40, i: ClosedOpen, d: Asc}, want: nil},
+	}
+
+	for _, opts := range [][]Option{nil, {WithAVL()}, {WithRedBlack()}} {
+		tree := New[int, int](opts...)
+		for _, key := range []int{40, 20, 60, 10, 30, 50, 70} {
+			tree.Insert(key, -key)
+		}
+
+		for _, tt := range tests {
+			t1.Run(tt.name, func(t1 *testing.T) {
+				var got []int
+				for _, e := range tree.Range(tt.args.lo, tt.args.hi, tt.args.i, tt.args.d) {
+					if e.Value != -e.Key {
+						t1.Errorf("Range() value of %v = %v, want %v", e.Key, e.Value, -e.Key)
+					}
+					got = append(got, e.Key)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t1.Errorf("Range() = %v, want %v", got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestTree_RangeFunc(t1 *testing.T) {
+	t1.Run("early stop", func(t1 *testing.T) {
+		tree := New[int, int](WithRedBlack())
+		for i := 0; i < 100; i++ {
+			tree.Insert(i, i)
+		}
+
+		var got []int
+		tree.RangeFunc(10, 90, Closed, Desc, func(key, value int) bool {
+			got = append(got, key)
+			return len(got) < 3
+		})
+		if want := []int{90, 89, 88}; !reflect.DeepEqual(got, want) {
+			t1.Errorf("RangeFunc() visited %v, want %v", got, want)
+		}
+	})
+
+	t1.Run("repeated inserts", func(t1 *testing.T) {
+		tree := New[int, int](WithAVL())
+		for i, key := range []int{5, 3, 5, 7, 5, 5, 1} {
+			tree.Insert(key, i)
+		}
+
+		var got []int
+		tree.RangeFunc(5, 5, Closed, Asc, func(key, value int) bool {
+			got = append(got, value)
+			return true
+		})
+		if want := []int{5}; !reflect.DeepEqual(got, want) {
+			t1.Errorf("RangeFunc() visited %v, want %v", got, want)
+		}
+	})
+
+	t1.Run("subtrees outside bounds are pruned", func(t1 *testing.T) {
+		calls := 0
+		tree := NewWithComparator[int, int](func(a, b int) int {
+			calls++
+			return cmp.Compare(a, b)
+		}, WithAVL())
+		for i := 0; i < 1<<12; i++ {
+			tree.Insert(i, i)
+		}
+
+		calls = 0
+		if got := tree.Range(2000, 2004, Closed, Asc); len(got) != 5 {
+			t1.Fatalf("Range() returned %d elements, want 5", len(got))
+		}
+		if calls > 40 {
+			t1.Errorf("Range() made %d comparisons, want at most 40", calls)
+		}
+	})
+}
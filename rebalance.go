@@ -0,0 +1,50 @@
+package tree
+
+import "math/bits"
+
+// Rebalance is a function for making the plain tree balanced (its height becomes about log2(n))
+// by Day–Stout–Warren algorithm: the tree is turned into a sorted list by right rotations
+// and then compressed by left rotations. It takes O(n) time and O(1) extra memory,
+// parent pointers and values of elements are kept.
+// AVL and red-black trees are always balanced, so Rebalance does nothing for them.
+func (t *Tree[K, V]) Rebalance() {
+	if t.balance != unbalanced || t.root == nil {
+		return
+	}
+
+	n := t.toVine()
+	leaves := n + 1 - 1<<(bits.Len(uint(n+1))-1)
+	t.compress(leaves)
+	for m := n - leaves; m > 1; {
+		m /= 2
+		t.compress(m)
+	}
+
+	for n := postOrderFirst(t.root); n != nil; n = postOrderNext(n) {
+		n.update()
+	}
+}
+
+// toVine turns the tree into the list of nodes linked by right children (vine) and returns its length.
+func (t *Tree[K, V]) toVine() int {
+	count := 0
+	for n := t.root; n != nil; {
+		if n.left != nil {
+			n = t.rotateRight(n)
+			continue
+		}
+
+		count++
+		n = n.right
+	}
+
+	return count
+}
+
+// compress rotates left every second node of the top of the vine count times.
+func (t *Tree[K, V]) compress(count int) {
+	n := t.root
+	for i := 0; i < count; i++ {
+		n = t.rotateLeft(n).right
+	}
+}
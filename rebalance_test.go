@@ -0,0 +1,68 @@
+package tree
+
+import (
+	"math/bits"
+	"reflect"
+	"testing"
+)
+
+func TestTree_Rebalance(t1 *testing.T) {
+	tests := []struct {
+		name string
+		keys []int
+	}{
+		{name: "empty tree"},
+		{name: "one element", keys: []int{1}},
+		{name: "perfect tree size", keys: ascending(15)},
+		{name: "ascending keys", keys: ascending(1000)},
+		{name: "descending keys", keys: []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}},
+		{name: "zigzag keys", keys: []int{0, 100, 1, 99, 2, 98, 3, 97, 4, 96, 5}},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			tree := New[int, int]()
+			for _, key := range tt.keys {
+				tree.InsertWithoutRecursion(key, key*10)
+			}
+			want := tree.InOrderTreeWalk(Asc)
+
+			tree.Rebalance()
+			if err := tree.Validate(); err != nil {
+				t1.Fatalf("Validate() = %v", err)
+			}
+			if got := tree.InOrderTreeWalk(Asc); !reflect.DeepEqual(got, want) {
+				t1.Errorf("InOrderTreeWalk() = %v, want %v", got, want)
+			}
+			if wantHeight := bits.Len(uint(len(tt.keys))); tree.Height() != wantHeight {
+				t1.Errorf("Height() = %v, want %v", tree.Height(), wantHeight)
+			}
+			for key, value := range tree.All() {
+				if value != key*10 {
+					t1.Fatalf("value of %v = %v, want %v", key, value, key*10)
+				}
+			}
+		})
+	}
+}
+
+func TestTree_RebalanceSelfBalancing(t1 *testing.T) {
+	tree := New[int, int](WithRedBlack())
+	for _, key := range ascending(100) {
+		tree.Insert(key, key)
+	}
+	want := tree.PreOrderTreeWalk(Asc)
+
+	tree.Rebalance()
+	if got := tree.PreOrderTreeWalk(Asc); !reflect.DeepEqual(got, want) {
+		t1.Errorf("Rebalance() changed the shape of red-black tree")
+	}
+}
+
+func ascending(n int) []int {
+	keys := make([]int, n)
+	for i := range keys {
+		keys[i] = i
+	}
+
+	return keys
+}
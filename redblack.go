@@ -0,0 +1,126 @@
+package tree
+
+const (
+	black color = false
+	red   color = true
+)
+
+// color is a type which uses to set the color of red-black tree's node.
+type color bool
+
+func isRed[K, V any](n *node[K, V]) bool {
+	return n != nil && n.color == red
+}
+
+// redBlackRotateLeft is rotateLeft which also recalculates heights of the ancestors of the rotated subtree.
+// Unlike AVL, fixups of red-black tree don't walk up to the root after rotations,
+// so the heights above would stay stale (sizes don't change on rotations).
+func (t *Tree[K, V]) redBlackRotateLeft(x *node[K, V]) {
+	updateToRoot(t.rotateLeft(x).parent)
+}
+
+// redBlackRotateRight is rotateRight which also recalculates heights of the ancestors of the rotated subtree.
+func (t *Tree[K, V]) redBlackRotateRight(x *node[K, V]) {
+	updateToRoot(t.rotateRight(x).parent)
+}
+
+// redBlackInsertFixup colors new leaf z red and restores red-black properties
+// by recoloring and rotating on the way up.
+func (t *Tree[K, V]) redBlackInsertFixup(z *node[K, V]) {
+	z.color = red
+
+	for isRed(z.parent) {
+		p := z.parent
+		g := p.parent // red node is never the root, so grandparent exists
+
+		if p == g.left {
+			if uncle := g.right; isRed(uncle) {
+				p.color, uncle.color, g.color = black, black, red
+				z = g
+				continue
+			}
+
+			if z == p.right {
+				z = p
+				t.redBlackRotateLeft(z)
+				p = z.parent
+			}
+			p.color, g.color = black, red
+			t.redBlackRotateRight(g)
+			continue
+		}
+
+		if uncle := g.left; isRed(uncle) {
+			p.color, uncle.color, g.color = black, black, red
+			z = g
+			continue
+		}
+
+		if z == p.left {
+			z = p
+			t.redBlackRotateRight(z)
+			p = z.parent
+		}
+		p.color, g.color = black, red
+		t.redBlackRotateLeft(g)
+	}
+
+	t.root.color = black
+}
+
+// redBlackDeleteFixup restores red-black properties after removing a black node.
+// x has taken the place of the removed node (it can be nil), parent is the parent of that place.
+func (t *Tree[K, V]) redBlackDeleteFixup(x, parent *node[K, V]) {
+	for x != t.root && !isRed(x) {
+		if x == parent.left {
+			w := parent.right
+			if isRed(w) {
+				w.color, parent.color = black, red
+				t.redBlackRotateLeft(parent)
+				w = parent.right
+			}
+
+			if !isRed(w.left) && !isRed(w.right) {
+				w.color = red
+				x, parent = parent, parent.parent
+				continue
+			}
+
+			if !isRed(w.right) {
+				w.left.color, w.color = black, red
+				t.redBlackRotateRight(w)
+				w = parent.right
+			}
+			w.color, parent.color, w.right.color = parent.color, black, black
+			t.redBlackRotateLeft(parent)
+			x = t.root
+			continue
+		}
+
+		w := parent.left
+		if isRed(w) {
+			w.color, parent.color = black, red
+			t.redBlackRotateRight(parent)
+			w = parent.left
+		}
+
+		if !isRed(w.left) && !isRed(w.right) {
+			w.color = red
+			x, parent = parent, parent.parent
+			continue
+		}
+
+		if !isRed(w.left) {
+			w.right.color, w.color = black, red
+			t.redBlackRotateLeft(w)
+			w = parent.left
+		}
+		w.color, parent.color, w.left.color = parent.color, black, black
+		t.redBlackRotateRight(parent)
+		x = t.root
+	}
+
+	if x != nil {
+		x.color = black
+	}
+}
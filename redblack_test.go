@@ -0,0 +1,169 @@
+package tree
+
+import (
+	"cmp"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// checkRedBlack verifies colors and parent pointers of the subtree and returns its black height.
+func checkRedBlack[K cmp.Ordered](t *testing.T, n *node[K, int]) int {
+	t.Helper()
+	if n == nil {
+		return 1
+	}
+
+	for _, child := range []*node[K, int]{n.left, n.right} {
+		if child == nil {
+			continue
+		}
+		if child.parent != n {
+			t.Fatalf("node %v: child %v has wrong parent", n.element.key, child.element.key)
+		}
+		if isRed(n) && isRed(child) {
+			t.Fatalf("node %v: red node has red child %v", n.element.key, child.element.key)
+		}
+	}
+
+	l, r := checkRedBlack(t, n.left), checkRedBlack(t, n.right)
+	if l != r {
+		t.Fatalf("node %v: black height of left = %d, of right = %d", n.element.key, l, r)
+	}
+	if n.color == black {
+		l++
+	}
+
+	return l
+}
+
+func TestTree_RedBlackInsert(t1 *testing.T) {
+	type testCase struct {
+		name   string
+		insert func(t *Tree[int, int], key int)
+		keys   []int
+	}
+
+	var ascending, descending, zigzag []int
+	for i := 0; i < 1000; i++ {
+		ascending = append(ascending, i)
+		descending = append(descending, 1000-i)
+		zigzag = append(zigzag, i*(1-2*(i%2)))
+	}
+
+	tests := []testCase{
+		{
+			name:   "ascending keys",
+			insert: func(t *Tree[int, int], key int) { t.Insert(key, key) },
+			keys:   ascending,
+		},
+		{
+			name:   "descending keys",
+			insert: func(t *Tree[int, int], key int) { t.Insert(key, key) },
+			keys:   descending,
+		},
+		{
+			name:   "zigzag keys without recursion",
+			insert: func(t *Tree[int, int], key int) { t.InsertWithoutRecursion(key, key) },
+			keys:   zigzag,
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			tree := New[int, int](WithRedBlack())
+			for _, key := range tt.keys {
+				tt.insert(tree, key)
+			}
+
+			if isRed(tree.root) {
+				t1.Fatalf("root is red")
+			}
+			checkRedBlack(t1, tree.root)
+
+			got := tree.InOrderTreeWalkWithStack(Asc)
+			if len(got) != len(tt.keys) {
+				t1.Errorf("InOrderTreeWalkWithStack() returned %d keys, want %d", len(got), len(tt.keys))
+			}
+			for i := 1; i < len(got); i++ {
+				if got[i-1] > got[i] {
+					t1.Fatalf("InOrderTreeWalkWithStack() is not sorted at %d: %v > %v", i, got[i-1], got[i])
+				}
+			}
+
+			depth, maxDepth := 0, int(2*math.Log2(float64(len(tt.keys)+1)))
+			for n := tree.root; n != nil; n = n.left {
+				depth++
+			}
+			if depth > maxDepth {
+				t1.Errorf("depth of minimum = %d, want at most %d", depth, maxDepth)
+			}
+		})
+	}
+}
+
+func TestTree_RedBlackDelete(t1 *testing.T) {
+	tree := New[int, int](WithRedBlack())
+	for i := 0; i < 512; i++ {
+		tree.Insert(i, i)
+	}
+
+	var want []int
+	for i := 0; i < 512; i++ {
+		if i%3 == 0 {
+			tree.Delete(i)
+			checkRedBlack(t1, tree.root)
+			continue
+		}
+		want = append(want, i)
+	}
+
+	if got := tree.InOrderTreeWalk(Asc); !reflect.DeepEqual(got, want) {
+		t1.Errorf("InOrderTreeWalk() = %v, want %v", got, want)
+	}
+
+	for i := len(want) - 1; i >= 0; i-- {
+		if value, ok := tree.GetValue(want[i]); !ok || value != want[i] {
+			t1.Fatalf("GetValue(%v) = %v, %v", want[i], value, ok)
+		}
+		tree.Delete(want[i])
+		if isRed(tree.root) {
+			t1.Fatalf("root is red")
+		}
+		checkRedBlack(t1, tree.root)
+	}
+
+	if tree.root != nil {
+		t1.Errorf("root = %v, want nil", tree.root.element.key)
+	}
+}
+
+// checkHeights verifies cached heights of the subtree and returns its height.
+func checkHeights(t *testing.T, n *node[int, int]) int {
+	t.Helper()
+	if n == nil {
+		return 0
+	}
+
+	h := checkHeights(t, n.left) + 1
+	if r := checkHeights(t, n.right) + 1; r > h {
+		h = r
+	}
+	if n.height != h {
+		t.Fatalf("node %v: cached height = %d, want %d", n.element.key, n.height, h)
+	}
+
+	return h
+}
+
+func TestTree_RedBlackHeights(t1 *testing.T) {
+	tree := New[int, int](WithRedBlack())
+	for i := 0; i < 200; i++ {
+		tree.Insert(i, i)
+		checkHeights(t1, tree.root)
+	}
+
+	for i := 0; i < 200; i += 3 {
+		tree.Delete(i)
+		checkHeights(t1, tree.root)
+	}
+}
@@ -0,0 +1,89 @@
+package tree
+
+import "math/bits"
+
+// Stats is the structure of tree's shape statistics, see Tree.Stats.
+type Stats struct {
+	// Nodes is the number of tree's elements.
+	Nodes int
+	// Height is the number of nodes on the longest path from the root to a leaf.
+	Height int
+	// Leaves is the number of nodes without children.
+	Leaves int
+	// AvgDepth is the average depth of nodes (the root has depth 0).
+	AvgDepth float64
+	// MaxDepth is the depth of the deepest node, it is Height-1 for not empty tree.
+	MaxDepth int
+	// HeightRatio is Height divided by the height of perfectly balanced tree with the same number of nodes
+	// (1 is the best, the list of n nodes has about n/log2(n)).
+	HeightRatio float64
+}
+
+// Height is a function for getting the number of nodes on the longest path from the root to a leaf.
+// It is 0 for the empty tree, heights are cached in nodes, so it is O(1).
+func (t *Tree[K, V]) Height() int {
+	return height(t.root)
+}
+
+// Depth is a function for getting the number of edges from the root to the element with the key (root has depth 0).
+// - param key should exist in the tree
+// - error is *KeyError wrapping ErrNotFound if element with the key doesn't exist
+func (t *Tree[K, V]) Depth(key K) (int, error) {
+	depth := 0
+	for n := t.root; n != nil; depth++ {
+		c := t.cmp(key, n.element.key)
+		if c == 0 {
+			return depth, nil
+		}
+
+		if c < 0 {
+			n = n.left
+			continue
+		}
+		n = n.right
+	}
+
+	return 0, &KeyError[K]{Op: "Depth", Key: key, Err: ErrNotFound}
+}
+
+// Stats is a function for getting shape statistics of the tree, it can help to decide when to rebalance it.
+// The tree is walked without recursion, so even a degenerate tree doesn't overflow the stack.
+func (t *Tree[K, V]) Stats() Stats {
+	var s Stats
+	if t.root == nil {
+		return s
+	}
+
+	type item struct {
+		n     *node[K, V]
+		depth int
+	}
+
+	totalDepth := 0
+	stack := []item{{t.root, 0}}
+	for len(stack) > 0 {
+		it := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		s.Nodes++
+		totalDepth += it.depth
+		if it.depth > s.MaxDepth {
+			s.MaxDepth = it.depth
+		}
+		if it.n.left == nil && it.n.right == nil {
+			s.Leaves++
+		}
+
+		for _, child := range []*node[K, V]{it.n.left, it.n.right} {
+			if child != nil {
+				stack = append(stack, item{child, it.depth + 1})
+			}
+		}
+	}
+
+	s.Height = s.MaxDepth + 1
+	s.AvgDepth = float64(totalDepth) / float64(s.Nodes)
+	s.HeightRatio = float64(s.Height) / float64(bits.Len(uint(s.Nodes)))
+
+	return s
+}
@@ -0,0 +1,80 @@
+package tree
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTree_Stats(t1 *testing.T) {
+	tests := []struct {
+		name string
+		keys []int
+		want Stats
+	}{
+		{
+			name: "empty tree",
+			want: Stats{},
+		},
+		{
+			name: "one element",
+			keys: []int{1},
+			want: Stats{Nodes: 1, Height: 1, Leaves: 1, HeightRatio: 1},
+		},
+		{
+			name: "perfect tree",
+			keys: []int{8, 4, 12, 2, 6, 10, 14},
+			want: Stats{Nodes: 7, Height: 3, Leaves: 4, AvgDepth: 10.0 / 7, MaxDepth: 2, HeightRatio: 1},
+		},
+		{
+			name: "list",
+			keys: []int{1, 2, 3, 4},
+			want: Stats{Nodes: 4, Height: 4, Leaves: 1, AvgDepth: 1.5, MaxDepth: 3, HeightRatio: 4.0 / 3},
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			tree := New[int, int]()
+			for _, key := range tt.keys {
+				tree.Insert(key, key)
+			}
+
+			if got := tree.Stats(); got != tt.want {
+				t1.Errorf("Stats() = %+v, want %+v", got, tt.want)
+			}
+			if got := tree.Height(); got != tt.want.Height {
+				t1.Errorf("Height() = %v, want %v", got, tt.want.Height)
+			}
+		})
+	}
+}
+
+func TestTree_StatsOfDegenerateTree(t1 *testing.T) {
+	tree := New[int, int]()
+	for i := 0; i < 10000; i++ {
+		tree.InsertWithoutRecursion(i, i)
+	}
+
+	s := tree.Stats()
+	if s.Nodes != 10000 || s.Height != 10000 || s.Leaves != 1 {
+		t1.Errorf("Stats() = %+v, want 10000 nodes, height 10000 and 1 leaf", s)
+	}
+	if got, err := tree.Depth(9999); err != nil || got != 9999 {
+		t1.Errorf("Depth(9999) = %v, %v, want 9999, nil", got, err)
+	}
+}
+
+func TestTree_Depth(t1 *testing.T) {
+	tree := New[int, int](WithAVL())
+	for i := 1; i <= 7; i++ {
+		tree.Insert(i, i)
+	}
+
+	for key, want := range map[int]int{4: 0, 2: 1, 6: 1, 1: 2, 7: 2} {
+		if got, err := tree.Depth(key); err != nil || got != want {
+			t1.Errorf("Depth(%v) = %v, %v, want %v, nil", key, got, err, want)
+		}
+	}
+	if _, err := tree.Depth(8); !errors.Is(err, ErrNotFound) {
+		t1.Errorf("Depth(8) error = %v, want %v", err, ErrNotFound)
+	}
+}
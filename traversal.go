@@ -0,0 +1,232 @@
+package tree
+
+// Walk is a function for visiting elements in sorted order (inOrder traversal) without allocation.
+// Every traversal order has the same kind of function: Walk, MorrisWalk, PreOrderWalk, PostOrderWalk and LevelOrderWalk.
+// - param d sets the order of elements (Asc or Desc)
+// - param fn is called for every element, the walk stops when it returns false
+func (t *Tree[K, V]) Walk(d direction, fn func(key K, value V) bool) {
+	if d == Asc {
+		for n := min(t.root); n != nil; n = next(n) {
+			if !fn(n.element.key, n.element.value) {
+				return
+			}
+		}
+		return
+	}
+
+	for n := max(t.root); n != nil; n = prev(n) {
+		if !fn(n.element.key, n.element.value) {
+			return
+		}
+	}
+}
+
+// PreOrderTreeWalk is a function for getting keys in preOrder (node, left subtree, right subtree).
+// Order of the result repeats the shape of the tree, so inserting keys in this order rebuilds the same tree.
+// - param d sets which child is visited first: Asc - left, Desc - right
+func (t *Tree[K, V]) PreOrderTreeWalk(d direction) []K {
+	if t.root == nil {
+		return nil
+	}
+
+	return preOrderTreeWalk(t.root, d, nil)
+}
+
+// PreOrderTreeWalkWithStack is a function for getting keys in preOrder (node, left subtree, right subtree)
+// without recursion.
+// - param d sets which child is visited first: Asc - left, Desc - right
+func (t *Tree[K, V]) PreOrderTreeWalkWithStack(d direction) []K {
+	var result []K
+	t.PreOrderWalk(d, func(key K, _ V) bool {
+		result = append(result, key)
+		return true
+	})
+
+	return result
+}
+
+// PreOrderWalk is a function for visiting elements in preOrder (node, left subtree, right subtree).
+// - param d sets which child is visited first: Asc - left, Desc - right
+// - param fn is called for every element, the walk stops when it returns false
+func (t *Tree[K, V]) PreOrderWalk(d direction, fn func(key K, value V) bool) {
+	if t.root == nil {
+		return
+	}
+
+	stack := []*node[K, V]{t.root}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !fn(n.element.key, n.element.value) {
+			return
+		}
+
+		first, second := children(n, d)
+		if second != nil {
+			stack = append(stack, second)
+		}
+		if first != nil {
+			stack = append(stack, first)
+		}
+	}
+}
+
+// PostOrderTreeWalk is a function for getting keys in postOrder (left subtree, right subtree, node).
+// - param d sets which child is visited first: Asc - left, Desc - right
+func (t *Tree[K, V]) PostOrderTreeWalk(d direction) []K {
+	if t.root == nil {
+		return nil
+	}
+
+	return postOrderTreeWalk(t.root, d, nil)
+}
+
+// PostOrderTreeWalkWithStack is a function for getting keys in postOrder (left subtree, right subtree, node)
+// without recursion.
+// - param d sets which child is visited first: Asc - left, Desc - right
+func (t *Tree[K, V]) PostOrderTreeWalkWithStack(d direction) []K {
+	var result []K
+	t.PostOrderWalk(d, func(key K, _ V) bool {
+		result = append(result, key)
+		return true
+	})
+
+	return result
+}
+
+// PostOrderWalk is a function for visiting elements in postOrder (left subtree, right subtree, node).
+// - param d sets which child is visited first: Asc - left, Desc - right
+// - param fn is called for every element, the walk stops when it returns false
+func (t *Tree[K, V]) PostOrderWalk(d direction, fn func(key K, value V) bool) {
+	var stack []*node[K, V]
+	var last *node[K, V]
+	curr := t.root
+
+	for curr != nil || len(stack) > 0 {
+		for curr != nil {
+			stack = append(stack, curr)
+			curr, _ = children(curr, d)
+		}
+
+		top := stack[len(stack)-1]
+		if _, second := children(top, d); second != nil && second != last {
+			curr = second
+			continue
+		}
+
+		stack = stack[:len(stack)-1]
+		if !fn(top.element.key, top.element.value) {
+			return
+		}
+		last = top
+	}
+}
+
+// LevelOrderTreeWalk is a function for getting keys in levelOrder (breadth-first, level by level from the root).
+// - param d sets the order of keys in every level: Asc - from left to right, Desc - from right to left
+func (t *Tree[K, V]) LevelOrderTreeWalk(d direction) []K {
+	var result []K
+	for _, level := range t.LevelOrderTreeWalkByDepth(d) {
+		result = append(result, level...)
+	}
+
+	return result
+}
+
+// LevelOrderTreeWalkByDepth is a function for getting keys in levelOrder grouped by depth:
+// i-th item of the result contains keys of nodes with depth i.
+// - param d sets the order of keys in every level: Asc - from left to right, Desc - from right to left
+func (t *Tree[K, V]) LevelOrderTreeWalkByDepth(d direction) [][]K {
+	if t.root == nil {
+		return nil
+	}
+
+	return levelOrderTreeWalk(t.root, d, 0, nil)
+}
+
+// LevelOrderTreeWalkWithQueue is a function for getting keys in levelOrder without recursion.
+// - param d sets the order of keys in every level: Asc - from left to right, Desc - from right to left
+func (t *Tree[K, V]) LevelOrderTreeWalkWithQueue(d direction) []K {
+	var result []K
+	t.LevelOrderWalk(d, func(key K, _ V) bool {
+		result = append(result, key)
+		return true
+	})
+
+	return result
+}
+
+// LevelOrderWalk is a function for visiting elements in levelOrder (breadth-first, level by level from the root).
+// - param d sets the order of elements in every level: Asc - from left to right, Desc - from right to left
+// - param fn is called for every element, the walk stops when it returns false
+func (t *Tree[K, V]) LevelOrderWalk(d direction, fn func(key K, value V) bool) {
+	if t.root == nil {
+		return
+	}
+
+	queue := []*node[K, V]{t.root}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		if !fn(n.element.key, n.element.value) {
+			return
+		}
+
+		first, second := children(n, d)
+		if first != nil {
+			queue = append(queue, first)
+		}
+		if second != nil {
+			queue = append(queue, second)
+		}
+	}
+}
+
+// children returns children of the node in order of visiting: left first for Asc, right first for Desc.
+func children[K, V any](n *node[K, V], d direction) (*node[K, V], *node[K, V]) {
+	if d == Desc {
+		return n.right, n.left
+	}
+
+	return n.left, n.right
+}
+
+func preOrderTreeWalk[K, V any](n *node[K, V], d direction, result []K) []K {
+	if n == nil {
+		return result
+	}
+
+	first, second := children(n, d)
+	result = append(result, n.element.key)
+	result = preOrderTreeWalk(first, d, result)
+
+	return preOrderTreeWalk(second, d, result)
+}
+
+func postOrderTreeWalk[K, V any](n *node[K, V], d direction, result []K) []K {
+	if n == nil {
+		return result
+	}
+
+	first, second := children(n, d)
+	result = postOrderTreeWalk(first, d, result)
+	result = postOrderTreeWalk(second, d, result)
+
+	return append(result, n.element.key)
+}
+
+func levelOrderTreeWalk[K, V any](n *node[K, V], d direction, depth int, levels [][]K) [][]K {
+	if n == nil {
+		return levels
+	}
+
+	if len(levels) == depth {
+		levels = append(levels, nil)
+	}
+	levels[depth] = append(levels[depth], n.element.key)
+
+	first, second := children(n, d)
+	levels = levelOrderTreeWalk(first, d, depth+1, levels)
+
+	return levelOrderTreeWalk(second, d, depth+1, levels)
+}
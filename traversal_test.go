@@ -0,0 +1,180 @@
+package tree
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTree_Traversals(t1 *testing.T) {
+	tree := New[int, int]()
+	for _, key := range []int{50, 30, 70, 20, 40, 60, 80, 35} {
+		tree.Insert(key, key)
+	}
+
+	type testCase struct {
+		name string
+		walk func(d direction) []int
+		asc  []int
+		desc []int
+	}
+
+	preAsc := []int{50, 30, 20, 40, 35, 70, 60, 80}
+	preDesc := []int{50, 70, 80, 60, 30, 40, 35, 20}
+	postAsc := []int{20, 35, 40, 30, 60, 80, 70, 50}
+	postDesc := []int{80, 60, 70, 35, 40, 20, 30, 50}
+	levelAsc := []int{50, 30, 70, 20, 40, 60, 80, 35}
+	levelDesc := []int{50, 70, 30, 80, 60, 40, 20, 35}
+
+	collect := func(walk func(d direction, fn func(key, value int) bool)) func(d direction) []int {
+		return func(d direction) []int {
+			var result []int
+			walk(d, func(key, value int) bool {
+				result = append(result, key)
+				return true
+			})
+			return result
+		}
+	}
+
+	tests := []testCase{
+		{name: "PreOrderTreeWalk", walk: tree.PreOrderTreeWalk, asc: preAsc, desc: preDesc},
+		{name: "PreOrderTreeWalkWithStack", walk: tree.PreOrderTreeWalkWithStack, asc: preAsc, desc: preDesc},
+		{name: "PreOrderWalk", walk: collect(tree.PreOrderWalk), asc: preAsc, desc: preDesc},
+		{name: "PostOrderTreeWalk", walk: tree.PostOrderTreeWalk, asc: postAsc, desc: postDesc},
+		{name: "PostOrderTreeWalkWithStack", walk: tree.PostOrderTreeWalkWithStack, asc: postAsc, desc: postDesc},
+		{name: "PostOrderWalk", walk: collect(tree.PostOrderWalk), asc: postAsc, desc: postDesc},
+		{name: "LevelOrderTreeWalk", walk: tree.LevelOrderTreeWalk, asc: levelAsc, desc: levelDesc},
+		{name: "LevelOrderTreeWalkWithQueue", walk: tree.LevelOrderTreeWalkWithQueue, asc: levelAsc, desc: levelDesc},
+		{name: "LevelOrderWalk", walk: collect(tree.LevelOrderWalk), asc: levelAsc, desc: levelDesc},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			if got := tt.walk(Asc); !reflect.DeepEqual(got, tt.asc) {
+				t1.Errorf("%s(Asc) = %v, want %v", tt.name, got, tt.asc)
+			}
+			if got := tt.walk(Desc); !reflect.DeepEqual(got, tt.desc) {
+				t1.Errorf("%s(Desc) = %v, want %v", tt.name, got, tt.desc)
+			}
+			if got := tt.walk(Asc); len(got) != tree.Len() {
+				t1.Errorf("%s(Asc) visited %d keys, want %d", tt.name, len(got), tree.Len())
+			}
+		})
+	}
+
+	t1.Run("LevelOrderTreeWalkByDepth", func(t1 *testing.T) {
+		want := [][]int{{50}, {30, 70}, {20, 40, 60, 80}, {35}}
+		if got := tree.LevelOrderTreeWalkByDepth(Asc); !reflect.DeepEqual(got, want) {
+			t1.Errorf("LevelOrderTreeWalkByDepth(Asc) = %v, want %v", got, want)
+		}
+		want = [][]int{{50}, {70, 30}, {80, 60, 40, 20}, {35}}
+		if got := tree.LevelOrderTreeWalkByDepth(Desc); !reflect.DeepEqual(got, want) {
+			t1.Errorf("LevelOrderTreeWalkByDepth(Desc) = %v, want %v", got, want)
+		}
+	})
+
+	t1.Run("preOrder rebuilds the same tree", func(t1 *testing.T) {
+		copied := New[int, int]()
+		for _, key := range tree.PreOrderTreeWalk(Asc) {
+			copied.Insert(key, key)
+		}
+		if !reflect.DeepEqual(copied.root, tree.root) {
+			t1.Errorf("tree built from preOrder keys differs from the original")
+		}
+	})
+}
+
+func TestTree_TraversalsStop(t1 *testing.T) {
+	tree := New[int, int](WithAVL())
+	for i := 0; i < 100; i++ {
+		tree.Insert(i, i)
+	}
+
+	walks := map[string]func(d direction, fn func(key, value int) bool){
+		"Walk":           tree.Walk,
+		"MorrisWalk":     tree.MorrisWalk,
+		"PreOrderWalk":   tree.PreOrderWalk,
+		"PostOrderWalk":  tree.PostOrderWalk,
+		"LevelOrderWalk": tree.LevelOrderWalk,
+	}
+	for name, walk := range walks {
+		t1.Run(name, func(t1 *testing.T) {
+			visited := 0
+			walk(Asc, func(key, value int) bool {
+				visited++
+				return visited < 5
+			})
+			if visited != 5 {
+				t1.Errorf("%s visited %d elements after stop, want 5", name, visited)
+			}
+		})
+	}
+}
+
+func TestTree_TraversalsEmpty(t1 *testing.T) {
+	tree := New[int, int]()
+	if got := tree.PreOrderTreeWalk(Asc); got != nil {
+		t1.Errorf("PreOrderTreeWalk() = %v, want nil", got)
+	}
+	if got := tree.PostOrderTreeWalkWithStack(Asc); got != nil {
+		t1.Errorf("PostOrderTreeWalkWithStack() = %v, want nil", got)
+	}
+	if got := tree.LevelOrderTreeWalkByDepth(Asc); got != nil {
+		t1.Errorf("LevelOrderTreeWalkByDepth() = %v, want nil", got)
+	}
+}
+
+func TestTree_Walk(t1 *testing.T) {
+	tree := New[int, string](WithRedBlack())
+	for _, key := range []int{40, 20, 60, 10, 30, 50, 70} {
+		tree.Insert(key, string(rune('a'+key/10)))
+	}
+
+	type testCase struct {
+		name string
+		d    direction
+		want []string
+	}
+	tests := []testCase{
+		{name: "asc", d: Asc, want: []string{"b", "c", "d", "e", "f", "g", "h"}},
+		{name: "desc", d: Desc, want: []string{"h", "g", "f", "e", "d", "c", "b"}},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			var got []string
+			tree.Walk(tt.d, func(key int, value string) bool {
+				got = append(got, value)
+				return true
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t1.Errorf("Walk() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t1.Run("find first match", func(t1 *testing.T) {
+		walks := map[string]func(d direction, fn func(key int, value string) bool){
+			"Walk":           tree.Walk,
+			"MorrisWalk":     tree.MorrisWalk,
+			"PreOrderWalk":   tree.PreOrderWalk,
+			"PostOrderWalk":  tree.PostOrderWalk,
+			"LevelOrderWalk": tree.LevelOrderWalk,
+		}
+		for name, walk := range walks {
+			found, visited := 0, 0
+			walk(Asc, func(key int, value string) bool {
+				visited++
+				if value == "d" {
+					found = key
+					return false
+				}
+				return true
+			})
+			if found != 30 {
+				t1.Errorf("%s found %v, want 30", name, found)
+			}
+			if name == "Walk" && visited != 3 {
+				t1.Errorf("%s visited %d elements, want 3", name, visited)
+			}
+		}
+	})
+}
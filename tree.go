@@ -1,12 +1,7 @@
 // Package tree is a package for work with Binary trees.
 package tree
 
-import (
-	"errors"
-	"fmt"
-
-	"golang.org/x/exp/constraints"
-)
+import "cmp"
 
 const (
 	// Desc specifies the sort direction to be descending.
@@ -18,35 +13,58 @@ const (
 // direction is a type which uses to set the direction (Asc or Desc).
 type direction string
 
-type Tree[V constraints.Ordered] struct {
-	root *node[V]
+// Tree is the structure of binary search tree.
+// Keys are ordered by the tree's comparator, so create trees with New or NewWithComparator.
+type Tree[K, V any] struct {
+	root    *node[K, V]
+	cmp     func(a, b K) int
+	balance balancing
 }
 
 // New is a function for creation empty tree
-// - param should be `ordered type` (`int`, `string`, `float` etc)
-func New[V constraints.Ordered]() *Tree[V] {
-	return &Tree[V]{}
+// - type param K (key) should be `ordered type` (`int`, `string`, `float` etc)
+// - type param V (value) can be any type
+// - opts can be used for choosing self-balancing variant of the tree (WithAVL, WithRedBlack)
+func New[K cmp.Ordered, V any](opts ...Option) *Tree[K, V] {
+	return NewWithComparator[K, V](cmp.Compare[K], opts...)
+}
+
+// NewWithComparator is a function for creation empty tree with custom order of keys
+// - type param K (key) can be any type
+// - param cmp should return a negative number when a < b, zero when a == b and a positive number when a > b
+// - opts can be used for choosing self-balancing variant of the tree (WithAVL, WithRedBlack)
+func NewWithComparator[K, V any](cmp func(a, b K) int, opts ...Option) *Tree[K, V] {
+	s := newSettings(opts)
+
+	return &Tree[K, V]{cmp: cmp, balance: s.balance}
 }
 
 // NewWithElement is a function for creation tree with one element
-// - param should be `ordered type` (`int`, `string`, `float` etc)
-func NewWithElement[V constraints.Ordered](key V, value any) *Tree[V] {
-	return &Tree[V]{
-		root: &node[V]{
-			element: element[V]{
+// - param key should be `ordered type` (`int`, `string`, `float` etc)
+// - param value can be any type
+func NewWithElement[K cmp.Ordered, V any](key K, value V) *Tree[K, V] {
+	return &Tree[K, V]{
+		cmp: cmp.Compare[K],
+		root: &node[K, V]{
+			element: element[K, V]{
 				key:   key,
 				value: value,
 			},
+			height: 1,
+			size:   1,
 		},
 	}
 }
 
-// Insert is a function for inserting element into node
-// - param key should be `ordered type` (`int`, `string`, `float` etc.)
+// Insert is a function for inserting element into node.
+// If element with the key already exists, its value is replaced (like in a map).
 // - param value can be any type
-func (t *Tree[V]) Insert(key V, value any) {
-	n := &node[V]{
-		element: element[V]{
+// - returns the previous value and true if the value was replaced
+func (t *Tree[K, V]) Insert(key K, value V) (V, bool) {
+	t.mustHaveComparator()
+	var old V
+	n := &node[K, V]{
+		element: element[K, V]{
 			key:   key,
 			value: value,
 		},
@@ -54,49 +72,78 @@ func (t *Tree[V]) Insert(key V, value any) {
 
 	if t.root == nil {
 		t.root = n
-		return
+		t.afterInsert(n)
+		return old, false
+	}
+
+	if existing := t.root.insertNode(n, t.cmp); existing != nil {
+		return existing.replaceValue(value)
 	}
 
-	t.root.insertNode(n)
+	t.afterInsert(n)
+	return old, false
 }
 
-// InsertWithoutRecursion is a function for inserting element into node
-// - param key should be `ordered type` (`int`, `string`, `float` etc.)
+// InsertWithoutRecursion is a function for inserting element into node.
+// If element with the key already exists, its value is replaced (like in a map).
 // - param value can be any type
-func (t *Tree[V]) InsertWithoutRecursion(key V, value any) {
-	newNode := &node[V]{element: element[V]{
-		key:   key,
-		value: value,
-	}}
-
-	if t.root == nil {
-		t.root = newNode
-		return
+// - returns the previous value and true if the value was replaced
+func (t *Tree[K, V]) InsertWithoutRecursion(key K, value V) (V, bool) {
+	var old V
+	n, parent, place := t.locate(key)
+	if n != nil {
+		return n.replaceValue(value)
 	}
 
-	current := t.root
-	for {
-		if key < current.element.key {
-			if current.left == nil {
-				current.left = newNode
-				newNode.parent = current
-				return
-			}
-			current = current.left
+	t.attach(key, value, parent, place)
+	return old, false
+}
+
+// locate finds the node with the key by one descent from the root.
+// If the key exists, it returns the node and nil parent and place.
+// If the key doesn't exist, it returns nil and the place for the new leaf with its parent (see attach).
+func (t *Tree[K, V]) locate(key K) (n, parent *node[K, V], place **node[K, V]) {
+	t.mustHaveComparator()
+	place = &t.root
+	for *place != nil {
+		parent = *place
+		c := t.cmp(key, parent.element.key)
+		if c == 0 {
+			return parent, nil, nil
 		}
 
-		if current.right == nil {
-			current.right = newNode
-			newNode.parent = current
-			return
+		if c < 0 {
+			place = &parent.left
+			continue
 		}
-		current = current.right
+		place = &parent.right
+	}
+
+	return nil, parent, place
+}
+
+// attach puts new leaf with the key and the value to the place found by locate and rebalances the tree.
+func (t *Tree[K, V]) attach(key K, value V, parent *node[K, V], place **node[K, V]) {
+	n := &node[K, V]{element: element[K, V]{
+		key:   key,
+		value: value,
+	}}
+	addLeaf(n, parent, place)
+	t.afterInsert(n)
+}
+
+// mustHaveComparator panics if the tree wasn't created by a constructor:
+// the zero Tree has no comparator, so the second insert would fail with nil pointer dereference.
+func (t *Tree[K, V]) mustHaveComparator() {
+	if t.cmp == nil {
+		panic("tree: use New or NewWithComparator to create Tree, the zero Tree has no comparator")
 	}
 }
 
 // Min is a function for searching min element in tree (by key).
-func (t *Tree[V]) Min() V {
-	var result V
+// It returns zero value for the empty tree, use MinElement to tell it from the zero key.
+func (t *Tree[K, V]) Min() K {
+	var result K
 	n := t.root
 	if n == nil {
 		return result
@@ -110,8 +157,9 @@ func (t *Tree[V]) Min() V {
 }
 
 // Max is a function for searching max element in tree (by key).
-func (t *Tree[V]) Max() V {
-	var result V
+// It returns zero value for the empty tree, use MaxElement to tell it from the zero key.
+func (t *Tree[K, V]) Max() K {
+	var result K
 	n := t.root
 	if n == nil {
 		return result
@@ -124,10 +172,50 @@ func (t *Tree[V]) Max() V {
 	return n.element.key
 }
 
+// MinElement is a function for getting the element with the min key.
+// Unlike Min it reports the empty tree with ErrEmptyTree.
+func (t *Tree[K, V]) MinElement() (K, V, error) {
+	if t.root == nil {
+		var key K
+		var value V
+		return key, value, ErrEmptyTree
+	}
+
+	key, value, _ := unpack(min(t.root))
+	return key, value, nil
+}
+
+// MaxElement is a function for getting the element with the max key.
+// Unlike Max it reports the empty tree with ErrEmptyTree.
+func (t *Tree[K, V]) MaxElement() (K, V, error) {
+	if t.root == nil {
+		var key K
+		var value V
+		return key, value, ErrEmptyTree
+	}
+
+	key, value, _ := unpack(max(t.root))
+	return key, value, nil
+}
+
+// Len is a function for getting the number of tree's elements.
+func (t *Tree[K, V]) Len() int {
+	return size(t.root)
+}
+
+// IsEmpty is a function for checking that the tree has no elements.
+func (t *Tree[K, V]) IsEmpty() bool {
+	return t.root == nil
+}
+
+// Clear is a function for deleting all elements from the tree.
+func (t *Tree[K, V]) Clear() {
+	t.root = nil
+}
+
 // Exists is a function for searching element in node. If element exists in tree- return true, else - false
-// - param key should be `ordered type` (`int`, `string`, `float` etc)
-func (t *Tree[V]) Exists(key V) bool {
-	searchNode := search(t.root, key)
+func (t *Tree[K, V]) Exists(key K) bool {
+	searchNode := search(t.root, key, t.cmp)
 	if searchNode == nil {
 		return false
 	}
@@ -136,20 +224,19 @@ func (t *Tree[V]) Exists(key V) bool {
 }
 
 // GetValue is a function for searching element in node and returning value of this element
-// - param key should be `ordered type` (`int`, `string`, `float` etc)
-func (t *Tree[V]) GetValue(key V) (any, error) {
-	var result any
-	searchNode := search(t.root, key)
+// - second result is false if element with the key doesn't exist
+func (t *Tree[K, V]) GetValue(key K) (V, bool) {
+	var result V
+	searchNode := search(t.root, key, t.cmp)
 	if searchNode == nil {
-		return result, errors.New(fmt.Sprintf("element with key %v not found", key))
+		return result, false
 	}
 
-	return searchNode.element.value, nil
+	return searchNode.element.value, true
 }
 
 // InOrderTreeWalk is a function for getting ordered array of tree's elements.
-// - param key should be `ordered type` (`int`, `string`, `float` etc)
-func (t *Tree[V]) InOrderTreeWalk(d direction) []V {
+func (t *Tree[K, V]) InOrderTreeWalk(d direction) []K {
 	if t.root == nil {
 		return nil
 	}
@@ -158,14 +245,13 @@ func (t *Tree[V]) InOrderTreeWalk(d direction) []V {
 }
 
 // InOrderTreeWalkWithStack is a function for getting ordered array of tree's elements.
-// - param key should be `ordered type` (`int`, `string`, `float` etc)
-func (t *Tree[V]) InOrderTreeWalkWithStack(d direction) []V {
+func (t *Tree[K, V]) InOrderTreeWalkWithStack(d direction) []K {
 	if t.root == nil {
 		return nil
 	}
 
-	var stack []*node[V]
-	var result []V
+	var stack []*node[K, V]
+	var result []K
 	curr := t.root
 
 	for curr != nil || len(stack) > 0 {
@@ -191,102 +277,164 @@ func (t *Tree[V]) InOrderTreeWalkWithStack(d direction) []V {
 	return result
 }
 
-// PreOrderSuccessor is a function for searching preOrder key for income element (if we found it by input key param)
-// - param key should be `ordered type` (`int`, `string`, `float` etc)
-func (t *Tree[V]) PreOrderSuccessor(key V) (V, error) {
-	var result V
-	searchNode := search(t.root, key)
-	if searchNode == nil || searchNode.parent == nil {
-		return result, errors.New(fmt.Sprintf("PreOrderSuccessor for key %v not found", key))
+// Successor is a function for searching the next key in sorted order (the smallest key greater than the key).
+// It walks from the found node using parent pointers.
+// - param key should exist in the tree
+// - error is *KeyError wrapping ErrNotFound or ErrNoSuccessor
+func (t *Tree[K, V]) Successor(key K) (K, error) {
+	var result K
+	n := search(t.root, key, t.cmp)
+	if n == nil {
+		return result, &KeyError[K]{Op: "Successor", Key: key, Err: ErrNotFound}
 	}
 
-	return searchNode.parent.element.key, nil
-}
-
-// PostOrderSuccessor is a function for searching postOrder key for income element (if we found it by input key param)
-// - param key should be `ordered type` (`int`, `string`, `float` etc)
-func (t *Tree[V]) PostOrderSuccessor(key V) (V, error) {
-	var result V
-	searchNode := search(t.root, key)
-	if searchNode == nil || searchNode.right == nil {
-		return result, errors.New(fmt.Sprintf("postOrderSuccessor for key %v not found", key))
+	n = next(n)
+	if n == nil {
+		return result, &KeyError[K]{Op: "Successor", Key: key, Err: ErrNoSuccessor}
 	}
 
-	return searchNode.right.element.key, nil
+	return n.element.key, nil
 }
 
-// Delete is a function for deleting node in node
-// - param key should be `ordered type` (`int`, `string`, `float` etc)
-func (t *Tree[V]) Delete(key V) {
-	delNode := search(t.root, key)
-	if delNode == nil {
-		return
+// Predecessor is a function for searching the previous key in sorted order (the greatest key less than the key).
+// It walks from the found node using parent pointers.
+// - param key should exist in the tree
+// - error is *KeyError wrapping ErrNotFound or ErrNoPredecessor
+func (t *Tree[K, V]) Predecessor(key K) (K, error) {
+	var result K
+	n := search(t.root, key, t.cmp)
+	if n == nil {
+		return result, &KeyError[K]{Op: "Predecessor", Key: key, Err: ErrNotFound}
 	}
 
-	if delNode == t.root && t.root.hasNoChildren() {
-		t.root = nil
-		return
+	n = prev(n)
+	if n == nil {
+		return result, &KeyError[K]{Op: "Predecessor", Key: key, Err: ErrNoPredecessor}
 	}
 
-	// first case (node without children)
-	if delNode.element.key == key && delNode.hasNoChildren() {
-		if delNode.parent.right.element.key == key {
-			delNode.parent.right = nil
-			return
-		}
+	return n.element.key, nil
+}
 
-		delNode.parent.left = nil
-		return
+// PreOrderSuccessor is a function for searching the key which follows the key in preOrder traversal
+// (node, left subtree, right subtree)
+// - param key should exist in the tree
+// - error is *KeyError wrapping ErrNotFound or ErrNoSuccessor
+func (t *Tree[K, V]) PreOrderSuccessor(key K) (K, error) {
+	var result K
+	searchNode := search(t.root, key, t.cmp)
+	if searchNode == nil {
+		return result, &KeyError[K]{Op: "PreOrderSuccessor", Key: key, Err: ErrNotFound}
 	}
 
-	// second case
-	if delNode.left == nil && delNode.right != nil {
-		delNode.right.parent = delNode.parent
+	n := preOrderNext(searchNode)
+	if n == nil {
+		return result, &KeyError[K]{Op: "PreOrderSuccessor", Key: key, Err: ErrNoSuccessor}
+	}
 
-		if delNode == t.root {
-			t.root = t.root.right
-			return
-		}
+	return n.element.key, nil
+}
 
-		if delNode.parent.right == delNode {
-			delNode.parent.right = delNode.right
-			return
-		}
+// PostOrderSuccessor is a function for searching the key which follows the key in postOrder traversal
+// (left subtree, right subtree, node)
+// - param key should exist in the tree
+// - error is *KeyError wrapping ErrNotFound or ErrNoSuccessor
+func (t *Tree[K, V]) PostOrderSuccessor(key K) (K, error) {
+	var result K
+	searchNode := search(t.root, key, t.cmp)
+	if searchNode == nil {
+		return result, &KeyError[K]{Op: "PostOrderSuccessor", Key: key, Err: ErrNotFound}
+	}
 
-		delNode.parent.left = delNode.left
-		return
+	n := postOrderNext(searchNode)
+	if n == nil {
+		return result, &KeyError[K]{Op: "PostOrderSuccessor", Key: key, Err: ErrNoSuccessor}
 	}
 
-	if delNode.left != nil && delNode.right == nil {
-		delNode.left.parent = delNode.parent
+	return n.element.key, nil
+}
 
-		if delNode == t.root {
-			t.root = t.root.left
-			return
-		}
+// Delete is a function for deleting node in node
+// - returns the value of the deleted element and false if element with the key doesn't exist
+func (t *Tree[K, V]) Delete(key K) (V, bool) {
+	var result V
+	delNode := search(t.root, key, t.cmp)
+	if delNode == nil {
+		return result, false
+	}
 
-		if delNode.parent.right == delNode {
-			delNode.parent.right = delNode.left
-			return
-		}
+	t.deleteNode(delNode)
+	return delNode.element.value, true
+}
 
-		delNode.parent.left = delNode.left
-		return
+// DeleteMin is a function for deleting the element with the min key.
+// - returns the deleted element and false if the tree is empty
+func (t *Tree[K, V]) DeleteMin() (K, V, bool) {
+	n := min(t.root)
+	if n != nil {
+		t.deleteNode(n)
 	}
 
-	//third case
-	m := min(delNode.right)
-	minElement := m.element
+	return unpack(n)
+}
 
-	if m.parent == delNode {
-		m.parent.right = nil
-	} else {
-		m.parent.left = nil
+// DeleteMax is a function for deleting the element with the max key.
+// - returns the deleted element and false if the tree is empty
+func (t *Tree[K, V]) DeleteMax() (K, V, bool) {
+	n := max(t.root)
+	if n != nil {
+		t.deleteNode(n)
 	}
 
-	m.parent = nil
+	return unpack(n)
+}
+
+// PopMin is a function for taking the element with the min key out of the tree (like from priority queue).
+// - second result is false if the tree is empty
+func (t *Tree[K, V]) PopMin() (Entry[K, V], bool) {
+	key, value, ok := t.DeleteMin()
+
+	return Entry[K, V]{Key: key, Value: value}, ok
+}
+
+// PopMax is a function for taking the element with the max key out of the tree.
+// - second result is false if the tree is empty
+func (t *Tree[K, V]) PopMax() (Entry[K, V], bool) {
+	key, value, ok := t.DeleteMax()
 
-	delNode.element = minElement
+	return Entry[K, V]{Key: key, Value: value}, ok
+}
+
+// deleteNode removes the node from the tree and rebalances it.
+func (t *Tree[K, V]) deleteNode(delNode *node[K, V]) {
+	// child takes the place of the node which leaves the tree, parent is the parent of that place
+	var child, parent *node[K, V]
+	removed := delNode.color
+
+	switch {
+	// first and second cases (node without children or with one child)
+	case delNode.left == nil:
+		child, parent = delNode.right, delNode.parent
+		t.transplant(delNode, delNode.right)
+	case delNode.right == nil:
+		child, parent = delNode.left, delNode.parent
+		t.transplant(delNode, delNode.left)
+	// third case: the minimum of the right subtree takes place of the deleted node
+	default:
+		m := min(delNode.right)
+		removed = m.color
+		child, parent = m.right, m
+		if m.parent != delNode {
+			parent = m.parent
+			t.transplant(m, m.right)
+			m.right = delNode.right
+			m.right.parent = m
+		}
+
+		t.transplant(delNode, m)
+		m.left = delNode.left
+		m.left.parent = m
+		m.color = delNode.color
+	}
 
-	return
+	t.afterDelete(child, parent, removed)
 }
@@ -3,7 +3,7 @@ package tree
 import "testing"
 
 func BenchmarkTreeInsert(b *testing.B) {
-	tree := &Tree[int]{}
+	tree := New[int, int]()
 
 	for i := 0; i < b.N; i++ {
 		tree.Insert(i, i)
@@ -11,9 +11,35 @@ func BenchmarkTreeInsert(b *testing.B) {
 }
 
 func BenchmarkTree_InsertWithoutRecursion(b *testing.B) {
-	tree := &Tree[int]{}
+	tree := New[int, int]()
 
 	for i := 0; i < b.N; i++ {
 		tree.InsertWithoutRecursion(i, i)
 	}
 }
+
+func BenchmarkTreeInsertAVL(b *testing.B) {
+	tree := New[int, int](WithAVL())
+
+	for i := 0; i < b.N; i++ {
+		tree.Insert(i, i)
+	}
+}
+
+func BenchmarkTreeInsertRedBlack(b *testing.B) {
+	tree := New[int, int](WithRedBlack())
+
+	for i := 0; i < b.N; i++ {
+		tree.Insert(i, i)
+	}
+}
+
+func BenchmarkFromSorted(b *testing.B) {
+	keys := make([]int, b.N)
+	for i := range keys {
+		keys[i] = i
+	}
+	b.ResetTimer()
+
+	FromSorted(keys, keys)
+}
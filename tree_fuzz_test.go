@@ -0,0 +1,242 @@
+package tree
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// fuzzOp is one operation of the operation log which is replayed against the tree and the map model.
+type fuzzOp struct {
+	kind  string
+	key   int
+	value int
+}
+
+// fuzzOpKinds is the list of operations, the byte of the fuzz input selects one of them.
+var fuzzOpKinds = []string{"insert", "insertnr", "delete", "get", "min", "max", "walk", "rebalance"}
+
+// fuzzModes is the list of tree variants which every operation log is replayed against.
+var fuzzModes = []struct {
+	name string
+	opts []Option
+}{
+	{name: "plain"},
+	{name: "avl", opts: []Option{WithAVL()}},
+	{name: "red-black", opts: []Option{WithRedBlack()}},
+}
+
+// decodeOps makes operations from the fuzz input, every operation takes 3 bytes: kind, key and value.
+// Keys are taken from the small range, so that inserts and deletes hit the same keys often.
+func decodeOps(data []byte) []fuzzOp {
+	var ops []fuzzOp
+	for ; len(data) >= 3; data = data[3:] {
+		ops = append(ops, fuzzOp{
+			kind:  fuzzOpKinds[int(data[0])%len(fuzzOpKinds)],
+			key:   int(data[1] % 64),
+			value: int(data[2]),
+		})
+	}
+
+	return ops
+}
+
+// formatOps makes the operation log, one operation per line: "insert 5 7", "delete 5", "min".
+func formatOps(ops []fuzzOp) string {
+	var b strings.Builder
+	for _, op := range ops {
+		switch op.kind {
+		case "insert", "insertnr":
+			fmt.Fprintf(&b, "%s %d %d\n", op.kind, op.key, op.value)
+		case "delete", "get":
+			fmt.Fprintf(&b, "%s %d\n", op.kind, op.key)
+		default:
+			fmt.Fprintf(&b, "%s\n", op.kind)
+		}
+	}
+
+	return b.String()
+}
+
+// parseOps reads the operation log made by formatOps, empty lines and lines starting with # are skipped.
+func parseOps(log string) ([]fuzzOp, error) {
+	var ops []fuzzOp
+	for i, line := range strings.Split(log, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		op := fuzzOp{kind: fields[0]}
+		args := 0
+		switch op.kind {
+		case "insert", "insertnr":
+			args = 2
+		case "delete", "get":
+			args = 1
+		case "min", "max", "walk", "rebalance":
+		default:
+			return nil, fmt.Errorf("line %d: unknown operation %q", i+1, op.kind)
+		}
+		if len(fields) != args+1 {
+			return nil, fmt.Errorf("line %d: %s takes %d arguments, got %d", i+1, op.kind, args, len(fields)-1)
+		}
+
+		var err error
+		if args > 0 {
+			if op.key, err = strconv.Atoi(fields[1]); err != nil {
+				return nil, fmt.Errorf("line %d: %w", i+1, err)
+			}
+		}
+		if args > 1 {
+			if op.value, err = strconv.Atoi(fields[2]); err != nil {
+				return nil, fmt.Errorf("line %d: %w", i+1, err)
+			}
+		}
+		ops = append(ops, op)
+	}
+
+	return ops, nil
+}
+
+// replayOps runs operations against the tree and the map model
+// and returns the first difference between them or the broken structure of the tree.
+func replayOps(ops []fuzzOp, opts ...Option) error {
+	tree := New[int, int](opts...)
+	model := make(map[int]int)
+
+	for i, op := range ops {
+		switch op.kind {
+		case "insert", "insertnr":
+			old, replaced := 0, false
+			if op.kind == "insert" {
+				old, replaced = tree.Insert(op.key, op.value)
+			} else {
+				old, replaced = tree.InsertWithoutRecursion(op.key, op.value)
+			}
+			wantOld, wantReplaced := model[op.key]
+			if old != wantOld || replaced != wantReplaced {
+				return fmt.Errorf("op %d (%s %d): got %v, %v, want %v, %v", i, op.kind, op.key, old, replaced, wantOld, wantReplaced)
+			}
+			model[op.key] = op.value
+		case "delete":
+			value, ok := tree.Delete(op.key)
+			wantValue, wantOk := model[op.key]
+			if value != wantValue || ok != wantOk {
+				return fmt.Errorf("op %d (delete %d): got %v, %v, want %v, %v", i, op.key, value, ok, wantValue, wantOk)
+			}
+			delete(model, op.key)
+		case "get":
+			value, ok := tree.GetValue(op.key)
+			wantValue, wantOk := model[op.key]
+			if value != wantValue || ok != wantOk {
+				return fmt.Errorf("op %d (get %d): got %v, %v, want %v, %v", i, op.key, value, ok, wantValue, wantOk)
+			}
+		case "min", "max":
+			got, want := tree.Min(), 0
+			if op.kind == "max" {
+				got = tree.Max()
+			}
+			if sorted := slices.Sorted(maps.Keys(model)); len(sorted) > 0 {
+				want = sorted[0]
+				if op.kind == "max" {
+					want = sorted[len(sorted)-1]
+				}
+			}
+			if got != want {
+				return fmt.Errorf("op %d (%s): got %v, want %v", i, op.kind, got, want)
+			}
+		case "rebalance":
+			tree.Rebalance()
+		case "walk":
+			want := slices.Sorted(maps.Keys(model))
+			if got := tree.InOrderTreeWalk(Asc); len(got)+len(want) > 0 && !reflect.DeepEqual(got, want) {
+				return fmt.Errorf("op %d (walk): InOrderTreeWalk(Asc) = %v, want %v", i, got, want)
+			}
+			slices.Reverse(want)
+			if got := tree.InOrderTreeWalkWithStack(Desc); len(got)+len(want) > 0 && !reflect.DeepEqual(got, want) {
+				return fmt.Errorf("op %d (walk): InOrderTreeWalkWithStack(Desc) = %v, want %v", i, got, want)
+			}
+			for key, value := range tree.All() {
+				if value != model[key] {
+					return fmt.Errorf("op %d (walk): All() value of %v = %v, want %v", i, key, value, model[key])
+				}
+			}
+		}
+
+		if tree.Len() != len(model) {
+			return fmt.Errorf("op %d (%s): Len() = %v, want %v", i, op.kind, tree.Len(), len(model))
+		}
+		if err := tree.Validate(); err != nil {
+			return fmt.Errorf("op %d (%s): %w", i, op.kind, err)
+		}
+	}
+
+	return nil
+}
+
+func FuzzTree(f *testing.F) {
+	f.Add([]byte{0, 10, 1, 0, 5, 2, 0, 3, 3, 6, 0, 0})
+	f.Add([]byte{1, 10, 1, 1, 5, 2, 1, 3, 3, 1, 7, 4, 6, 0, 0})
+	f.Add([]byte{0, 50, 0, 0, 30, 0, 0, 70, 0, 0, 60, 0, 0, 80, 0, 0, 65, 0, 2, 50, 0, 6, 0, 0})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		ops := decodeOps(data)
+		for _, mode := range fuzzModes {
+			if err := replayOps(ops, mode.opts...); err != nil {
+				t.Fatalf("%s: %v\noperation log:\n%s", mode.name, err, formatOps(ops))
+			}
+		}
+	})
+}
+
+// TestReplayOpLogs replays operation logs from testdata/oplog, minimized crashers of FuzzTree are saved there
+// as text files in formatOps format.
+func TestReplayOpLogs(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "oplog", "*.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ops, err := parseOps(string(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, mode := range fuzzModes {
+				if err := replayOps(ops, mode.opts...); err != nil {
+					t.Errorf("%s: %v", mode.name, err)
+				}
+			}
+		})
+	}
+}
+
+func TestParseOps(t *testing.T) {
+	ops := decodeOps([]byte{0, 70, 1, 1, 5, 2, 2, 3, 0, 3, 4, 0, 4, 0, 0, 5, 0, 0, 6, 0, 0})
+	got, err := parseOps("# comment\n\n" + formatOps(ops))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, ops) {
+		t.Errorf("parseOps(formatOps()) = %v, want %v", got, ops)
+	}
+
+	for _, log := range []string{"push 1", "insert 1", "delete x", "min 1"} {
+		if _, err := parseOps(log); err == nil {
+			t.Errorf("parseOps(%q) error = nil, want error", log)
+		}
+	}
+}
@@ -1,101 +1,117 @@
 package tree
 
 import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"math"
 	"reflect"
+	"strings"
 	"testing"
-
-	"golang.org/x/exp/constraints"
+	"time"
 )
 
 func TestNew(t *testing.T) {
-	type testCase[V constraints.Ordered] struct {
+	type testCase[K cmp.Ordered] struct {
 		name string
-		want *Tree[V]
+		want *Tree[K, int]
 	}
 	testInt := testCase[int]{
 		name: "int empty tree",
-		want: &Tree[int]{root: nil},
+		want: &Tree[int, int]{root: nil},
 	}
 	t.Run(testInt.name, func(t *testing.T) {
-		if got := New[int](); !reflect.DeepEqual(got, testInt.want) {
+		got := New[int, int]()
+		if !reflect.DeepEqual(got.root, testInt.want.root) || got.balance != testInt.want.balance {
 			t.Errorf("CreateNode() = %v, want %v", got, testInt.want)
 		}
+		if got.cmp == nil || got.cmp(1, 2) >= 0 {
+			t.Errorf("CreateNode() comparator doesn't order ints")
+		}
 	})
 
 	testString := testCase[string]{
-		name: "int empty tree",
-		want: &Tree[string]{root: nil},
+		name: "string empty tree",
+		want: &Tree[string, int]{root: nil},
 	}
 	t.Run(testString.name, func(t *testing.T) {
-		if got := New[int](); !reflect.DeepEqual(got, testInt.want) {
-			t.Errorf("CreateNode() = %v, want %v", got, testInt.want)
+		got := New[string, int]()
+		if !reflect.DeepEqual(got.root, testString.want.root) || got.balance != testString.want.balance {
+			t.Errorf("CreateNode() = %v, want %v", got, testString.want)
+		}
+		if got.cmp == nil || got.cmp("b", "a") <= 0 {
+			t.Errorf("CreateNode() comparator doesn't order strings")
 		}
 	})
 }
 
 func TestNewWithElement(t *testing.T) {
-	type args[V constraints.Ordered] struct {
-		key   V
+	type args[K cmp.Ordered] struct {
+		key   K
 		value any
 	}
-	type testCase[V constraints.Ordered] struct {
+	type testCase[K cmp.Ordered] struct {
 		name string
-		args args[V]
-		want *Tree[V]
+		args args[K]
+		want *Tree[K, any]
 	}
 
 	intTests := []testCase[int]{
 		{
 			name: "empty value",
 			args: args[int]{key: 1, value: nil},
-			want: &Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			want: &Tree[int, any]{
+				root: &node[int, any]{
+					element: element[int, any]{
 						key:   1,
 						value: nil,
 					},
+					height: 1,
+					size:   1,
 				},
 			},
 		},
 		{
 			name: "one element",
 			args: args[int]{key: 15, value: 15},
-			want: &Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			want: &Tree[int, any]{
+				root: &node[int, any]{
+					element: element[int, any]{
 						key:   15,
 						value: 15,
 					},
+					height: 1,
+					size:   1,
 				},
 			},
 		},
 	}
 	for _, tt := range intTests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewWithElement(tt.args.key, tt.args.value); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("NewWithElement() = %v, want %v", got, tt.want)
+			if got := NewWithElement(tt.args.key, tt.args.value); !reflect.DeepEqual(got.root, tt.want.root) {
+				t.Errorf("NewWithElement() = %v, want %v", got.root, tt.want.root)
 			}
 		})
 	}
 }
 
 func TestTree_Insert(t1 *testing.T) {
-	type args[V constraints.Ordered] struct {
-		key   V
-		value any
+	type args[K cmp.Ordered] struct {
+		key   K
+		value int
 	}
-	type testCase[V constraints.Ordered] struct {
+	type testCase[K cmp.Ordered] struct {
 		name string
-		t    Tree[V]
-		args args[V]
-		want Tree[V]
+		t    Tree[K, int]
+		args args[K]
+		want Tree[K, int]
 	}
 
-	treeWithOneElement := New[int]()
+	treeWithOneElement := New[int, int]()
 	treeWithOneElement.Insert(15, 15)
 	treeWithOneElement.Insert(25, 25)
 
-	treeWithTwoElements := New[int]()
+	treeWithTwoElements := New[int, int]()
 	treeWithTwoElements.Insert(15, 15)
 	treeWithTwoElements.Insert(25, 25)
 	treeWithTwoElements.Insert(35, 35)
@@ -103,28 +119,34 @@ func TestTree_Insert(t1 *testing.T) {
 	tests := []testCase[int]{
 		{
 			name: "empty tree",
-			t:    Tree[int]{},
+			t:    Tree[int, int]{cmp: cmp.Compare[int]},
 			args: args[int]{key: 15, value: 15},
-			want: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			want: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
 					parent: nil,
 					left:   nil,
 					right:  nil,
+					height: 1,
+					size:   1,
 				},
 			},
 		},
 		{
 			name: "tree with root and one element",
-			t: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			t: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
+					height: 1,
+					size:   1,
 				},
 			},
 			args: args[int]{key: 25, value: 25},
@@ -140,30 +162,33 @@ func TestTree_Insert(t1 *testing.T) {
 	for _, tt := range tests {
 		t1.Run(tt.name, func(t1 *testing.T) {
 			tt.t.Insert(tt.args.key, tt.args.value)
-			if !reflect.DeepEqual(tt.t, tt.want) {
-				t1.Errorf("Insert() = %#+v, want %#+v", tt.t, tt.want)
+			if tt.t.Len() != tt.want.Len() {
+				t1.Errorf("Insert() Len = %v, want %v", tt.t.Len(), tt.want.Len())
+			}
+			if !reflect.DeepEqual(tt.t.root, tt.want.root) {
+				t1.Errorf("Insert() = %#+v, want %#+v", tt.t.root, tt.want.root)
 			}
 		})
 	}
 }
 
 func TestTree_WithoutRecursion(t1 *testing.T) {
-	type args[V constraints.Ordered] struct {
-		key   V
-		value any
+	type args[K cmp.Ordered] struct {
+		key   K
+		value int
 	}
-	type testCase[V constraints.Ordered] struct {
+	type testCase[K cmp.Ordered] struct {
 		name string
-		t    Tree[V]
-		args args[V]
-		want Tree[V]
+		t    Tree[K, int]
+		args args[K]
+		want Tree[K, int]
 	}
 
-	treeWithOneElement := New[int]()
+	treeWithOneElement := New[int, int]()
 	treeWithOneElement.Insert(15, 15)
 	treeWithOneElement.Insert(25, 25)
 
-	treeWithTwoElements := New[int]()
+	treeWithTwoElements := New[int, int]()
 	treeWithTwoElements.Insert(15, 15)
 	treeWithTwoElements.Insert(25, 25)
 	treeWithTwoElements.Insert(35, 35)
@@ -171,28 +196,34 @@ func TestTree_WithoutRecursion(t1 *testing.T) {
 	tests := []testCase[int]{
 		{
 			name: "empty tree",
-			t:    Tree[int]{},
+			t:    Tree[int, int]{cmp: cmp.Compare[int]},
 			args: args[int]{key: 15, value: 15},
-			want: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			want: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
 					parent: nil,
 					left:   nil,
 					right:  nil,
+					height: 1,
+					size:   1,
 				},
 			},
 		},
 		{
 			name: "tree with root and one element",
-			t: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			t: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
+					height: 1,
+					size:   1,
 				},
 			},
 			args: args[int]{key: 25, value: 25},
@@ -205,41 +236,63 @@ func TestTree_WithoutRecursion(t1 *testing.T) {
 			want: *treeWithTwoElements,
 		},
 	}
+	treeWithLeftElement := New[int, int]()
+	treeWithLeftElement.Insert(15, 15)
+	treeWithLeftElement.Insert(10, 10)
+
+	treeWithLeftElements := New[int, int]()
+	treeWithLeftElements.Insert(15, 15)
+	treeWithLeftElements.Insert(10, 10)
+	treeWithLeftElements.Insert(5, 5)
+
+	tests = append(tests, testCase[int]{
+		name: "tree with root and left element - left descent",
+		t:    *treeWithLeftElement,
+		args: args[int]{key: 5, value: 5},
+		want: *treeWithLeftElements,
+	})
+
 	for _, tt := range tests {
 		t1.Run(tt.name, func(t1 *testing.T) {
 			tt.t.InsertWithoutRecursion(tt.args.key, tt.args.value)
-			if !reflect.DeepEqual(tt.t, tt.want) {
-				t1.Errorf("InsertWithoutRecursion() = %#+v, want %#+v", tt.t, tt.want)
+			if tt.t.Len() != tt.want.Len() {
+				t1.Errorf("InsertWithoutRecursion() Len = %v, want %v", tt.t.Len(), tt.want.Len())
+			}
+			if !reflect.DeepEqual(tt.t.root, tt.want.root) {
+				t1.Errorf("InsertWithoutRecursion() = %#+v, want %#+v", tt.t.root, tt.want.root)
 			}
 		})
 	}
 }
 
 func TestTree_Min(t1 *testing.T) {
-	type testCase[V constraints.Ordered] struct {
+	type testCase[K cmp.Ordered] struct {
 		name string
-		t    Tree[V]
-		want V
+		t    Tree[K, int]
+		want K
 	}
 
-	treeWithOneElement := New[int]()
+	treeWithOneElement := New[int, int]()
 	treeWithOneElement.Insert(15, 15)
 	treeWithOneElement.Insert(25, 25)
 
 	tests := []testCase[int]{
 		{
 			name: "empty tree",
-			t:    Tree[int]{root: nil},
+			t:    Tree[int, int]{root: nil, cmp: cmp.Compare[int]},
 			want: 0,
 		},
 		{
 			name: "tree with one element",
-			t: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			t: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
+					height: 1,
+					size:   1,
 				},
 			},
 			want: 15,
@@ -260,30 +313,33 @@ func TestTree_Min(t1 *testing.T) {
 }
 
 func TestTree_Max(t1 *testing.T) {
-	type testCase[V constraints.Ordered] struct {
+	type testCase[K cmp.Ordered] struct {
 		name string
-		t    Tree[V]
-		want V
+		t    Tree[K, int]
+		want K
 	}
 
-	treeWithOneElement := New[int]()
+	treeWithOneElement := New[int, int]()
 	treeWithOneElement.Insert(15, 15)
 	treeWithOneElement.Insert(25, 25)
 
 	tests := []testCase[int]{
 		{
 			name: "empty tree",
-			t:    Tree[int]{root: nil},
+			t:    Tree[int, int]{root: nil, cmp: cmp.Compare[int]},
 			want: 0,
 		},
 		{
 			name: "tree with one element",
-			t: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			t: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
+					height: 1,
+					size:   1,
 				},
 			},
 			want: 15,
@@ -304,34 +360,37 @@ func TestTree_Max(t1 *testing.T) {
 }
 
 func TestTree_Exist(t1 *testing.T) {
-	type args[V constraints.Ordered] struct {
-		key V
+	type args[K cmp.Ordered] struct {
+		key K
 	}
-	type testCase[V constraints.Ordered] struct {
+	type testCase[K cmp.Ordered] struct {
 		name string
-		t    Tree[V]
-		args args[V]
+		t    Tree[K, int]
+		args args[K]
 		want bool
 	}
-	treeWithOneElement := New[int]()
+	treeWithOneElement := New[int, int]()
 	treeWithOneElement.Insert(15, 15)
 	treeWithOneElement.Insert(25, 25)
 
 	tests := []testCase[int]{
 		{
 			name: "empty tree",
-			t:    Tree[int]{root: nil},
+			t:    Tree[int, int]{root: nil, cmp: cmp.Compare[int]},
 			args: args[int]{key: 1},
 			want: false,
 		},
 		{
 			name: "tree with one element - not found",
-			t: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			t: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
+					height: 1,
+					size:   1,
 				},
 			},
 			args: args[int]{key: 1},
@@ -339,12 +398,15 @@ func TestTree_Exist(t1 *testing.T) {
 		},
 		{
 			name: "tree with one element - found",
-			t: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			t: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
+					height: 1,
+					size:   1,
 				},
 			},
 			args: args[int]{key: 15},
@@ -377,32 +439,35 @@ func TestTree_InOrderTreeWalk(t1 *testing.T) {
 	type args struct {
 		d direction
 	}
-	type testCase[V constraints.Ordered] struct {
+	type testCase[K cmp.Ordered] struct {
 		name string
-		t    Tree[V]
+		t    Tree[K, int]
 		args args
-		want []V
+		want []K
 	}
 
-	treeWithOneElement := New[int]()
+	treeWithOneElement := New[int, int]()
 	treeWithOneElement.Insert(15, 15)
 	treeWithOneElement.Insert(25, 25)
 
 	tests := []testCase[int]{
 		{
 			name: "empty tree",
-			t:    Tree[int]{root: nil},
+			t:    Tree[int, int]{root: nil, cmp: cmp.Compare[int]},
 			args: args{d: Asc},
 			want: nil,
 		},
 		{
 			name: "tree with one element - asc",
-			t: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			t: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
+					height: 1,
+					size:   1,
 				},
 			},
 			args: args{d: Asc},
@@ -410,12 +475,15 @@ func TestTree_InOrderTreeWalk(t1 *testing.T) {
 		},
 		{
 			name: "tree with one element - asc",
-			t: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			t: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
+					height: 1,
+					size:   1,
 				},
 			},
 			args: args{d: Desc},
@@ -445,77 +513,86 @@ func TestTree_InOrderTreeWalk(t1 *testing.T) {
 }
 
 func TestTree_Delete(t1 *testing.T) {
-	type args[V constraints.Ordered] struct {
-		key V
+	type args[K cmp.Ordered] struct {
+		key K
 	}
-	type testCase[V constraints.Ordered] struct {
+	type testCase[K cmp.Ordered] struct {
 		name string
-		t    Tree[V]
-		args args[V]
-		want Tree[V]
+		t    Tree[K, int]
+		args args[K]
+		want Tree[K, int]
 	}
 
-	treeWithOneElement := New[int]()
+	treeWithOneElement := New[int, int]()
 	treeWithOneElement.Insert(15, 15)
 	treeWithOneElement.Insert(25, 25)
 
-	treeWithTwoElements := New[int]()
+	treeWithTwoElements := New[int, int]()
 	treeWithTwoElements.Insert(15, 15)
 	treeWithTwoElements.Insert(25, 25)
 	treeWithTwoElements.Insert(35, 35)
 
-	treeResult := New[int]()
+	treeResult := New[int, int]()
 	treeResult.Insert(15, 15)
 	treeResult.Insert(35, 35)
 
 	tests := []testCase[int]{
 		{
 			name: "empty tree",
-			t:    Tree[int]{},
+			t:    Tree[int, int]{cmp: cmp.Compare[int]},
 			args: args[int]{key: 1},
-			want: Tree[int]{},
+			want: Tree[int, int]{cmp: cmp.Compare[int]},
 		},
 		{
 			name: "tree only with root - without changes",
-			t: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			t: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
 					parent: nil,
 					left:   nil,
 					right:  nil,
+					height: 1,
+					size:   1,
 				},
 			},
 			args: args[int]{key: 1},
-			want: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			want: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
 					parent: nil,
 					left:   nil,
 					right:  nil,
+					height: 1,
+					size:   1,
 				},
 			},
 		},
 		{
 			name: "tree only with root - delete root",
-			t: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			t: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
 					parent: nil,
 					left:   nil,
 					right:  nil,
+					height: 1,
+					size:   1,
 				},
 			},
 			args: args[int]{key: 15},
-			want: Tree[int]{},
+			want: Tree[int, int]{cmp: cmp.Compare[int]},
 		},
 		{
 			name: "tree with elements - without changes",
@@ -527,15 +604,18 @@ func TestTree_Delete(t1 *testing.T) {
 			name: "tree with elements - delete node without children",
 			t:    *treeWithOneElement,
 			args: args[int]{key: 25},
-			want: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			want: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
 					parent: nil,
 					left:   nil,
 					right:  nil,
+					height: 1,
+					size:   1,
 				},
 			},
 		},
@@ -549,63 +629,78 @@ func TestTree_Delete(t1 *testing.T) {
 	for _, tt := range tests {
 		t1.Run(tt.name, func(t1 *testing.T) {
 			tt.t.Delete(tt.args.key)
-			if !reflect.DeepEqual(tt.t, tt.want) {
-				t1.Errorf("Delete() = %v, want %v", tt.t, tt.want)
+			if tt.t.Len() != tt.want.Len() {
+				t1.Errorf("Delete() Len = %v, want %v", tt.t.Len(), tt.want.Len())
+			}
+			if !reflect.DeepEqual(tt.t.root, tt.want.root) {
+				t1.Errorf("Delete() = %v, want %v", tt.t.root, tt.want.root)
 			}
 		})
 	}
 }
 
 func TestTree_DeleteThirdCase(t1 *testing.T) {
-	type args[V constraints.Ordered] struct {
-		key V
+	type args[K cmp.Ordered] struct {
+		key K
 	}
-	type testCase[V constraints.Ordered] struct {
+	type testCase[K cmp.Ordered] struct {
 		name string
-		t    Tree[V]
-		args args[V]
-		want Tree[V]
+		t    Tree[K, int]
+		args args[K]
+		want Tree[K, int]
 	}
 
-	tree := Tree[int]{
-		root: &node[int]{
-			element: element[int]{
+	tree := Tree[int, int]{
+		cmp: cmp.Compare[int],
+		root: &node[int, int]{
+			element: element[int, int]{
 				key:   15,
 				value: 15,
 			},
 			parent: nil,
-			left: &node[int]{
-				element: element[int]{
+			left: &node[int, int]{
+				element: element[int, int]{
 					key:   10,
 					value: 10,
 				},
 				parent: nil,
+				height: 1,
+				size:   1,
 			},
-			right: &node[int]{
-				element: element[int]{
+			right: &node[int, int]{
+				element: element[int, int]{
 					key:   25,
 					value: 25,
 				},
 				parent: nil,
+				height: 1,
+				size:   1,
 			},
+			height: 2,
+			size:   3,
 		},
 	}
 	tree.root.right.parent = tree.root
 	tree.root.left.parent = tree.root
 
-	treeResult := Tree[int]{
-		root: &node[int]{
-			element: element[int]{
+	treeResult := Tree[int, int]{
+		cmp: cmp.Compare[int],
+		root: &node[int, int]{
+			element: element[int, int]{
 				key:   25,
 				value: 25,
 			},
-			left: &node[int]{
-				element: element[int]{
+			left: &node[int, int]{
+				element: element[int, int]{
 					key:   10,
 					value: 10,
 				},
 				parent: nil,
+				height: 1,
+				size:   1,
 			},
+			height: 2,
+			size:   2,
 		},
 	}
 	treeResult.root.left.parent = treeResult.root
@@ -621,25 +716,28 @@ func TestTree_DeleteThirdCase(t1 *testing.T) {
 	for _, tt := range tests {
 		t1.Run(tt.name, func(t1 *testing.T) {
 			tt.t.Delete(tt.args.key)
-			if !reflect.DeepEqual(tt.t, tt.want) {
-				t1.Errorf("Delete() = %v, want %v", tt.t, tt.want)
+			if tt.t.Len() != tt.want.Len() {
+				t1.Errorf("Delete() Len = %v, want %v", tt.t.Len(), tt.want.Len())
+			}
+			if !reflect.DeepEqual(tt.t.root, tt.want.root) {
+				t1.Errorf("Delete() = %v, want %v", tt.t.root, tt.want.root)
 			}
 		})
 	}
 }
 
 func TestTree_DeleteSecondCase(t1 *testing.T) {
-	type args[V constraints.Ordered] struct {
-		key V
+	type args[K cmp.Ordered] struct {
+		key K
 	}
-	type testCase[V constraints.Ordered] struct {
+	type testCase[K cmp.Ordered] struct {
 		name string
-		t    Tree[V]
-		args args[V]
-		want Tree[V]
+		t    Tree[K, int]
+		args args[K]
+		want Tree[K, int]
 	}
 
-	treeWithOneElement := New[int]()
+	treeWithOneElement := New[int, int]()
 	treeWithOneElement.Insert(15, 15)
 	treeWithOneElement.Insert(25, 25)
 
@@ -648,15 +746,18 @@ func TestTree_DeleteSecondCase(t1 *testing.T) {
 			name: "tree with elements - delete root node with right children",
 			t:    *treeWithOneElement,
 			args: args[int]{key: 15},
-			want: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			want: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   25,
 						value: 25,
 					},
 					parent: nil,
 					left:   nil,
 					right:  nil,
+					height: 1,
+					size:   1,
 				},
 			},
 		},
@@ -664,44 +765,93 @@ func TestTree_DeleteSecondCase(t1 *testing.T) {
 	for _, tt := range tests {
 		t1.Run(tt.name, func(t1 *testing.T) {
 			tt.t.Delete(tt.args.key)
-			if !reflect.DeepEqual(tt.t, tt.want) {
-				t1.Errorf("Delete() = %v, want %v", tt.t, tt.want)
+			if tt.t.Len() != tt.want.Len() {
+				t1.Errorf("Delete() Len = %v, want %v", tt.t.Len(), tt.want.Len())
+			}
+			if !reflect.DeepEqual(tt.t.root, tt.want.root) {
+				t1.Errorf("Delete() = %v, want %v", tt.t.root, tt.want.root)
+			}
+		})
+	}
+}
+
+func TestTree_DeleteKeepsSubtrees(t1 *testing.T) {
+	type testCase[K cmp.Ordered] struct {
+		name   string
+		keys   []K
+		delete K
+		want   []K
+	}
+
+	tests := []testCase[int]{
+		{
+			name:   "left child with right subtree only",
+			keys:   []int{50, 30, 40, 35, 45},
+			delete: 30,
+			want:   []int{35, 40, 45, 50},
+		},
+		{
+			name:   "minimum of right subtree has right child",
+			keys:   []int{50, 30, 70, 60, 80, 65},
+			delete: 50,
+			want:   []int{30, 60, 65, 70, 80},
+		},
+		{
+			name:   "root with left subtree only",
+			keys:   []int{50, 30, 20},
+			delete: 50,
+			want:   []int{20, 30},
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			tree := New[int, int]()
+			for _, key := range tt.keys {
+				tree.Insert(key, key)
+			}
+
+			tree.Delete(tt.delete)
+			if got := tree.InOrderTreeWalk(Asc); !reflect.DeepEqual(got, tt.want) {
+				t1.Errorf("Delete() left keys %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
 func TestTree_PreOrderSuccessor(t1 *testing.T) {
-	type args[V constraints.Ordered] struct {
-		key V
+	type args[K cmp.Ordered] struct {
+		key K
 	}
-	type testCase[V constraints.Ordered] struct {
+	type testCase[K cmp.Ordered] struct {
 		name    string
-		t       Tree[V]
-		args    args[V]
-		want    V
+		t       Tree[K, int]
+		args    args[K]
+		want    K
 		wantErr bool
 	}
-	treeWithOneElement := New[int]()
+	treeWithOneElement := New[int, int]()
 	treeWithOneElement.Insert(15, 15)
 	treeWithOneElement.Insert(25, 25)
 
 	tests := []testCase[int]{
 		{
 			name:    "empty tree",
-			t:       Tree[int]{root: nil},
+			t:       Tree[int, int]{root: nil, cmp: cmp.Compare[int]},
 			args:    args[int]{key: 1},
 			want:    0,
 			wantErr: true,
 		},
 		{
 			name: "tree with one element - not found",
-			t: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			t: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
+					height: 1,
+					size:   1,
 				},
 			},
 			args:    args[int]{key: 1},
@@ -710,12 +860,15 @@ func TestTree_PreOrderSuccessor(t1 *testing.T) {
 		},
 		{
 			name: "tree with one element",
-			t: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			t: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
+					height: 1,
+					size:   1,
 				},
 			},
 			args:    args[int]{key: 15},
@@ -725,14 +878,14 @@ func TestTree_PreOrderSuccessor(t1 *testing.T) {
 		{
 			name:    "tree with root and one element - found",
 			t:       *treeWithOneElement,
-			args:    args[int]{key: 25},
-			want:    15,
+			args:    args[int]{key: 15},
+			want:    25,
 			wantErr: false,
 		},
 		{
 			name:    "tree with root and one element - not found",
 			t:       *treeWithOneElement,
-			args:    args[int]{key: 15},
+			args:    args[int]{key: 25},
 			want:    0,
 			wantErr: true,
 		},
@@ -752,36 +905,39 @@ func TestTree_PreOrderSuccessor(t1 *testing.T) {
 }
 
 func TestTree_PostOrderSuccessor(t1 *testing.T) {
-	type args[V constraints.Ordered] struct {
-		key V
+	type args[K cmp.Ordered] struct {
+		key K
 	}
-	type testCase[V constraints.Ordered] struct {
+	type testCase[K cmp.Ordered] struct {
 		name    string
-		t       Tree[V]
-		args    args[V]
-		want    V
+		t       Tree[K, int]
+		args    args[K]
+		want    K
 		wantErr bool
 	}
-	treeWithOneElement := New[int]()
+	treeWithOneElement := New[int, int]()
 	treeWithOneElement.Insert(15, 15)
 	treeWithOneElement.Insert(25, 25)
 
 	tests := []testCase[int]{
 		{
 			name:    "empty tree",
-			t:       Tree[int]{root: nil},
+			t:       Tree[int, int]{root: nil, cmp: cmp.Compare[int]},
 			args:    args[int]{key: 1},
 			want:    0,
 			wantErr: true,
 		},
 		{
 			name: "tree with one element - not found",
-			t: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			t: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
+					height: 1,
+					size:   1,
 				},
 			},
 			args:    args[int]{key: 1},
@@ -790,12 +946,15 @@ func TestTree_PostOrderSuccessor(t1 *testing.T) {
 		},
 		{
 			name: "tree with one element - found element, but don't found postOrder",
-			t: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			t: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
+					height: 1,
+					size:   1,
 				},
 			},
 			args:    args[int]{key: 15},
@@ -805,14 +964,14 @@ func TestTree_PostOrderSuccessor(t1 *testing.T) {
 		{
 			name:    "tree with root and one element - found",
 			t:       *treeWithOneElement,
-			args:    args[int]{key: 15},
-			want:    25,
+			args:    args[int]{key: 25},
+			want:    15,
 			wantErr: false,
 		},
 		{
 			name:    "tree with root and one element - not found",
 			t:       *treeWithOneElement,
-			args:    args[int]{key: 25},
+			args:    args[int]{key: 15},
 			want:    0,
 			wantErr: true,
 		},
@@ -832,76 +991,82 @@ func TestTree_PostOrderSuccessor(t1 *testing.T) {
 }
 
 func TestTree_GetValue(t1 *testing.T) {
-	type args[V constraints.Ordered] struct {
-		key V
+	type args[K cmp.Ordered] struct {
+		key K
 	}
-	type testCase[V constraints.Ordered] struct {
-		name    string
-		t       Tree[V]
-		args    args[V]
-		want    any
-		wantErr bool
+	type testCase[K cmp.Ordered] struct {
+		name   string
+		t      Tree[K, int]
+		args   args[K]
+		want   int
+		wantOk bool
 	}
-	treeWithOneElement := New[int]()
+	treeWithOneElement := New[int, int]()
 	treeWithOneElement.Insert(15, 15)
 	treeWithOneElement.Insert(25, 25)
 
 	tests := []testCase[int]{
 		{
-			name:    "empty tree",
-			t:       Tree[int]{root: nil},
-			args:    args[int]{key: 1},
-			want:    nil,
-			wantErr: true,
+			name:   "empty tree",
+			t:      Tree[int, int]{root: nil, cmp: cmp.Compare[int]},
+			args:   args[int]{key: 1},
+			want:   0,
+			wantOk: false,
 		},
 		{
 			name: "tree with one element - not found",
-			t: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			t: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
+					height: 1,
+					size:   1,
 				},
 			},
-			args:    args[int]{key: 1},
-			want:    nil,
-			wantErr: true,
+			args:   args[int]{key: 1},
+			want:   0,
+			wantOk: false,
 		},
 		{
 			name: "tree with one element - found",
-			t: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			t: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
+					height: 1,
+					size:   1,
 				},
 			},
-			args:    args[int]{key: 15},
-			want:    15,
-			wantErr: false,
+			args:   args[int]{key: 15},
+			want:   15,
+			wantOk: true,
 		},
 		{
-			name:    "tree with root and one element - found",
-			t:       *treeWithOneElement,
-			args:    args[int]{key: 25},
-			want:    25,
-			wantErr: false,
+			name:   "tree with root and one element - found",
+			t:      *treeWithOneElement,
+			args:   args[int]{key: 25},
+			want:   25,
+			wantOk: true,
 		},
 		{
-			name:    "tree with root and one element - not found",
-			t:       *treeWithOneElement,
-			args:    args[int]{key: 35},
-			want:    nil,
-			wantErr: true,
+			name:   "tree with root and one element - not found",
+			t:      *treeWithOneElement,
+			args:   args[int]{key: 35},
+			want:   0,
+			wantOk: false,
 		},
 	}
 	for _, tt := range tests {
 		t1.Run(tt.name, func(t1 *testing.T) {
-			got, err := tt.t.GetValue(tt.args.key)
-			if (err != nil) != tt.wantErr {
-				t1.Errorf("GetValue() error = %v, wantErr %v", err, tt.wantErr)
+			got, ok := tt.t.GetValue(tt.args.key)
+			if ok != tt.wantOk {
+				t1.Errorf("GetValue() ok = %v, wantOk %v", ok, tt.wantOk)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
@@ -915,31 +1080,34 @@ func TestTree_InOrderTreeWalkWithStack(t1 *testing.T) {
 	type args struct {
 		d direction
 	}
-	type testCase[V constraints.Ordered] struct {
+	type testCase[K cmp.Ordered] struct {
 		name string
-		t    Tree[V]
+		t    Tree[K, int]
 		args args
-		want []V
+		want []K
 	}
-	treeWithOneElement := New[int]()
+	treeWithOneElement := New[int, int]()
 	treeWithOneElement.Insert(15, 15)
 	treeWithOneElement.Insert(25, 25)
 
 	tests := []testCase[int]{
 		{
 			name: "empty tree",
-			t:    Tree[int]{root: nil},
+			t:    Tree[int, int]{root: nil, cmp: cmp.Compare[int]},
 			args: args{d: Asc},
 			want: nil,
 		},
 		{
 			name: "tree with one element - asc",
-			t: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			t: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
+					height: 1,
+					size:   1,
 				},
 			},
 			args: args{d: Asc},
@@ -947,12 +1115,15 @@ func TestTree_InOrderTreeWalkWithStack(t1 *testing.T) {
 		},
 		{
 			name: "tree with one element - asc",
-			t: Tree[int]{
-				root: &node[int]{
-					element: element[int]{
+			t: Tree[int, int]{
+				cmp: cmp.Compare[int],
+				root: &node[int, int]{
+					element: element[int, int]{
 						key:   15,
 						value: 15,
 					},
+					height: 1,
+					size:   1,
 				},
 			},
 			args: args{d: Desc},
@@ -980,3 +1151,331 @@ func TestTree_InOrderTreeWalkWithStack(t1 *testing.T) {
 		})
 	}
 }
+
+func TestAnyTree_GetValue(t1 *testing.T) {
+	tree := NewAny[int]()
+	tree.Insert(15, "fifteen")
+	tree.Insert(25, 25)
+
+	tests := []struct {
+		name    string
+		key     int
+		want    any
+		wantErr bool
+	}{
+		{name: "found string value", key: 15, want: "fifteen", wantErr: false},
+		{name: "found int value", key: 25, want: 25, wantErr: false},
+		{name: "not found", key: 35, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			got, err := tree.GetValue(tt.key)
+			if (err != nil) != tt.wantErr {
+				t1.Errorf("GetValue() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t1.Errorf("GetValue() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewWithComparator(t1 *testing.T) {
+	type point struct {
+		x, y int
+	}
+	byXY := func(a, b point) int {
+		if a.x != b.x {
+			return a.x - b.x
+		}
+		return a.y - b.y
+	}
+
+	t1.Run("struct keys", func(t1 *testing.T) {
+		tree := NewWithComparator[point, string](byXY)
+		tree.Insert(point{2, 1}, "c")
+		tree.Insert(point{1, 5}, "b")
+		tree.Insert(point{1, 2}, "a")
+
+		want := []point{{1, 2}, {1, 5}, {2, 1}}
+		if got := tree.InOrderTreeWalk(Asc); !reflect.DeepEqual(got, want) {
+			t1.Errorf("InOrderTreeWalk() = %v, want %v", got, want)
+		}
+		if got, ok := tree.GetValue(point{1, 5}); !ok || got != "b" {
+			t1.Errorf("GetValue() = %v, %v, want b, true", got, ok)
+		}
+		tree.Delete(point{1, 2})
+		if got := tree.Min(); got != (point{1, 5}) {
+			t1.Errorf("Min() = %v, want %v", got, point{1, 5})
+		}
+	})
+
+	t1.Run("reverse order", func(t1 *testing.T) {
+		tree := NewWithComparator[int, int](func(a, b int) int { return cmp.Compare(b, a) }, WithRedBlack())
+		for _, key := range []int{3, 1, 4, 1, 5, 9, 2, 6} {
+			tree.InsertWithoutRecursion(key, key)
+		}
+
+		want := []int{9, 6, 5, 4, 3, 2, 1}
+		if got := tree.InOrderTreeWalk(Asc); !reflect.DeepEqual(got, want) {
+			t1.Errorf("InOrderTreeWalk() = %v, want %v", got, want)
+		}
+		if got := tree.Min(); got != 9 {
+			t1.Errorf("Min() = %v, want 9", got)
+		}
+	})
+
+	t1.Run("case-insensitive strings", func(t1 *testing.T) {
+		tree := NewWithComparator[string, int](func(a, b string) int {
+			return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+		})
+		tree.Insert("banana", 1)
+		tree.Insert("Apple", 2)
+		tree.Insert("cherry", 3)
+
+		if !tree.Exists("BANANA") {
+			t1.Errorf("Exists(BANANA) = false, want true")
+		}
+		want := []string{"Apple", "banana", "cherry"}
+		if got := tree.InOrderTreeWalk(Asc); !reflect.DeepEqual(got, want) {
+			t1.Errorf("InOrderTreeWalk() = %v, want %v", got, want)
+		}
+	})
+
+	t1.Run("time and byte slice keys", func(t1 *testing.T) {
+		start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		times := NewWithComparator[time.Time, int](func(a, b time.Time) int { return a.Compare(b) }, WithAVL())
+		for i := 10; i > 0; i-- {
+			times.Insert(start.Add(time.Duration(i)*time.Hour), i)
+		}
+		if got := times.Min(); !got.Equal(start.Add(time.Hour)) {
+			t1.Errorf("Min() = %v, want %v", got, start.Add(time.Hour))
+		}
+
+		slices := NewWithComparator[[]byte, int](bytes.Compare)
+		slices.Insert([]byte("b"), 2)
+		slices.Insert([]byte("a"), 1)
+		if got, ok := slices.GetValue([]byte("a")); !ok || got != 1 {
+			t1.Errorf("GetValue(a) = %v, %v, want 1, true", got, ok)
+		}
+	})
+}
+
+func TestTree_NaNKeys(t1 *testing.T) {
+	tree := New[float64, int]()
+	for i, key := range []float64{1, math.NaN(), math.Inf(-1), 2, math.NaN()} {
+		tree.Insert(key, i)
+	}
+
+	// NaN is less than any other float and equal to NaN
+	if got := tree.Len(); got != 4 {
+		t1.Errorf("Len() = %v, want 4", got)
+	}
+	if got := tree.Min(); !math.IsNaN(got) {
+		t1.Errorf("Min() = %v, want NaN", got)
+	}
+	if value, ok := tree.GetValue(math.NaN()); !ok || value != 4 {
+		t1.Errorf("GetValue(NaN) = %v, %v, want 4, true", value, ok)
+	}
+	if got, err := tree.Successor(math.NaN()); err != nil || !math.IsInf(got, -1) {
+		t1.Errorf("Successor(NaN) = %v, %v, want -Inf, nil", got, err)
+	}
+}
+
+func TestTree_Len(t1 *testing.T) {
+	tree := New[int, int]()
+	if !tree.IsEmpty() || tree.Len() != 0 {
+		t1.Fatalf("new tree: IsEmpty() = %v, Len() = %v", tree.IsEmpty(), tree.Len())
+	}
+
+	tree.Insert(15, 15)
+	tree.InsertWithoutRecursion(10, 10)
+	tree.Insert(25, 25)
+	tree.Insert(25, 26)
+	if got := tree.Len(); got != 3 {
+		t1.Errorf("Len() after inserts = %v, want 3", got)
+	}
+
+	tree.Delete(25)
+	tree.Delete(99)
+	if got := tree.Len(); got != 2 {
+		t1.Errorf("Len() after deletes = %v, want 2", got)
+	}
+
+	tree.Clear()
+	if !tree.IsEmpty() || tree.Len() != 0 || tree.Exists(15) {
+		t1.Errorf("Clear() left IsEmpty() = %v, Len() = %v", tree.IsEmpty(), tree.Len())
+	}
+
+	single := NewWithElement(1, 1)
+	single.Delete(1)
+	if !single.IsEmpty() {
+		t1.Errorf("IsEmpty() after deleting root = false, want true")
+	}
+}
+
+func TestTree_Successors(t1 *testing.T) {
+	tree := New[int, int]()
+	for _, key := range []int{50, 30, 70, 20, 40, 60, 80, 35} {
+		tree.Insert(key, key)
+	}
+
+	tests := []struct {
+		name      string
+		successor func(key int) (int, error)
+		order     []int
+	}{
+		{name: "in order", successor: tree.Successor, order: []int{20, 30, 35, 40, 50, 60, 70, 80}},
+		{name: "in order - predecessor", successor: tree.Predecessor, order: []int{80, 70, 60, 50, 40, 35, 30, 20}},
+		{name: "pre order", successor: tree.PreOrderSuccessor, order: []int{50, 30, 20, 40, 35, 70, 60, 80}},
+		{name: "post order", successor: tree.PostOrderSuccessor, order: []int{20, 35, 40, 30, 60, 80, 70, 50}},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			for i, key := range tt.order {
+				got, err := tt.successor(key)
+				if i == len(tt.order)-1 {
+					if err == nil {
+						t1.Errorf("successor(%v) = %v, want error", key, got)
+					}
+					continue
+				}
+				if err != nil || got != tt.order[i+1] {
+					t1.Errorf("successor(%v) = %v, %v, want %v, nil", key, got, err, tt.order[i+1])
+				}
+			}
+
+			if _, err := tt.successor(99); err == nil {
+				t1.Errorf("successor(99) error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestTree_SuccessorWithRepeatedInserts(t1 *testing.T) {
+	tree := New[int, int](WithAVL())
+	for _, key := range []int{5, 5, 3, 5, 7, 5, 1} {
+		tree.Insert(key, key)
+	}
+
+	if got, err := tree.Successor(5); err != nil || got != 7 {
+		t1.Errorf("Successor(5) = %v, %v, want 7, nil", got, err)
+	}
+	if got, err := tree.Predecessor(5); err != nil || got != 3 {
+		t1.Errorf("Predecessor(5) = %v, %v, want 3, nil", got, err)
+	}
+}
+
+func TestTree_InsertReplace(t1 *testing.T) {
+	type testCase struct {
+		name   string
+		insert func(t *Tree[int, string], key int, value string) (string, bool)
+		opts   []Option
+	}
+
+	insert := func(t *Tree[int, string], key int, value string) (string, bool) { return t.Insert(key, value) }
+	withoutRecursion := func(t *Tree[int, string], key int, value string) (string, bool) {
+		return t.InsertWithoutRecursion(key, value)
+	}
+	tests := []testCase{
+		{name: "plain", insert: insert},
+		{name: "plain without recursion", insert: withoutRecursion},
+		{name: "avl", insert: insert, opts: []Option{WithAVL()}},
+		{name: "red-black without recursion", insert: withoutRecursion, opts: []Option{WithRedBlack()}},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			tree := New[int, string](tt.opts...)
+			for _, key := range []int{15, 10, 25, 5} {
+				if old, replaced := tt.insert(tree, key, "a"); replaced || old != "" {
+					t1.Fatalf("Insert(%v) = %q, %v, want \"\", false", key, old, replaced)
+				}
+			}
+
+			if old, replaced := tt.insert(tree, 10, "b"); !replaced || old != "a" {
+				t1.Errorf("Insert(10) = %q, %v, want \"a\", true", old, replaced)
+			}
+			if old, replaced := tt.insert(tree, 10, "c"); !replaced || old != "b" {
+				t1.Errorf("Insert(10) = %q, %v, want \"b\", true", old, replaced)
+			}
+			if got, _ := tree.GetValue(10); got != "c" {
+				t1.Errorf("GetValue(10) = %q, want \"c\"", got)
+			}
+			if got := tree.Len(); got != 4 {
+				t1.Errorf("Len() = %v, want 4", got)
+			}
+			if got, want := tree.InOrderTreeWalk(Asc), []int{5, 10, 15, 25}; !reflect.DeepEqual(got, want) {
+				t1.Errorf("InOrderTreeWalk() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestTree_DeleteMinMax(t1 *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{name: "plain"},
+		{name: "avl", opts: []Option{WithAVL()}},
+		{name: "red-black", opts: []Option{WithRedBlack()}},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			tree := New[int, int](tt.opts...)
+			for _, key := range []int{5, 8, 1, 9, 3, 7, 2} {
+				tree.Insert(key, key*10)
+			}
+
+			if key, value, ok := tree.DeleteMin(); !ok || key != 1 || value != 10 {
+				t1.Errorf("DeleteMin() = %v, %v, %v, want 1, 10, true", key, value, ok)
+			}
+			if key, value, ok := tree.DeleteMax(); !ok || key != 9 || value != 90 {
+				t1.Errorf("DeleteMax() = %v, %v, %v, want 9, 90, true", key, value, ok)
+			}
+
+			var got []int
+			for !tree.IsEmpty() {
+				e, _ := tree.PopMin()
+				got = append(got, e.Key)
+				if e, ok := tree.PopMax(); ok {
+					got = append(got, e.Key)
+				}
+			}
+			if want := []int{2, 8, 3, 7, 5}; !reflect.DeepEqual(got, want) {
+				t1.Errorf("PopMin() and PopMax() = %v, want %v", got, want)
+			}
+
+			if _, _, ok := tree.DeleteMin(); ok {
+				t1.Errorf("DeleteMin() of empty tree ok = true, want false")
+			}
+			if e, ok := tree.PopMax(); ok || e != (Entry[int, int]{}) {
+				t1.Errorf("PopMax() of empty tree = %v, %v, want zero entry, false", e, ok)
+			}
+		})
+	}
+}
+
+func TestTree_ZeroValuePanics(t1 *testing.T) {
+	tests := []struct {
+		name   string
+		insert func(t *Tree[int, int])
+	}{
+		{name: "Insert", insert: func(t *Tree[int, int]) { t.Insert(1, 1) }},
+		{name: "InsertWithoutRecursion", insert: func(t *Tree[int, int]) { t.InsertWithoutRecursion(1, 1) }},
+		{name: "InsertIfAbsent", insert: func(t *Tree[int, int]) { t.InsertIfAbsent(1, 1) }},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			defer func() {
+				if r := recover(); r == nil || !strings.Contains(fmt.Sprint(r), "use New or NewWithComparator") {
+					t1.Errorf("recover() = %v, want panic about New or NewWithComparator", r)
+				}
+			}()
+
+			var tree Tree[int, int]
+			tt.insert(&tree)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package tree
+
+// Update is a function for changing element with the key by one descent from the root.
+// fn gets the current value (or zero value and false if the key doesn't exist) and returns the new value:
+// if keep is true the new value is stored (element is inserted if it didn't exist),
+// if keep is false the element is deleted (nothing happens if it didn't exist).
+// - param fn shouldn't change the tree
+func (t *Tree[K, V]) Update(key K, fn func(old V, exists bool) (new V, keep bool)) {
+	n, parent, place := t.locate(key)
+	if n != nil {
+		value, keep := fn(n.element.value, true)
+		if !keep {
+			t.deleteNode(n)
+			return
+		}
+		n.element.value = value
+		return
+	}
+
+	var old V
+	if value, keep := fn(old, false); keep {
+		t.attach(key, value, parent, place)
+	}
+}
+
+// GetOrInsert is a function for getting value of the key, the value is created by factory if the key doesn't exist.
+// factory is called only for missing keys.
+// - second result is true if the element already existed
+func (t *Tree[K, V]) GetOrInsert(key K, factory func() V) (V, bool) {
+	n, parent, place := t.locate(key)
+	if n != nil {
+		return n.element.value, true
+	}
+
+	value := factory()
+	t.attach(key, value, parent, place)
+	return value, false
+}
+
+// InsertIfAbsent is a function for inserting element only if element with the key doesn't exist.
+// - returns true if the element was inserted
+func (t *Tree[K, V]) InsertIfAbsent(key K, value V) bool {
+	n, parent, place := t.locate(key)
+	if n != nil {
+		return false
+	}
+
+	t.attach(key, value, parent, place)
+	return true
+}
@@ -0,0 +1,105 @@
+package tree
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTree_Update(t1 *testing.T) {
+	increment := func(old int, exists bool) (int, bool) { return old + 1, true }
+	remove := func(old int, exists bool) (int, bool) { return old, false }
+
+	tests := []struct {
+		name       string
+		opts       []Option
+		key        int
+		fn         func(old int, exists bool) (int, bool)
+		wantKeys   []int
+		wantValue  int
+		wantExists bool
+	}{
+		{name: "change existing", key: 10, fn: increment, wantKeys: []int{5, 10, 15}, wantValue: 11, wantExists: true},
+		{name: "insert missing", opts: []Option{WithAVL()}, key: 7, fn: increment, wantKeys: []int{5, 7, 10, 15}, wantValue: 1, wantExists: true},
+		{name: "delete existing", opts: []Option{WithRedBlack()}, key: 10, fn: remove, wantKeys: []int{5, 15}},
+		{name: "delete missing", key: 7, fn: remove, wantKeys: []int{5, 10, 15}},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			tree := New[int, int](tt.opts...)
+			for _, key := range []int{10, 5, 15} {
+				tree.Insert(key, key)
+			}
+
+			tree.Update(tt.key, tt.fn)
+			switch tree.balance {
+			case avl:
+				checkAVL(t1, tree.root)
+			case redBlack:
+				checkRedBlack(t1, tree.root)
+			}
+			if got := tree.InOrderTreeWalk(Asc); !reflect.DeepEqual(got, tt.wantKeys) {
+				t1.Errorf("InOrderTreeWalk() = %v, want %v", got, tt.wantKeys)
+			}
+			if got := tree.Len(); got != len(tt.wantKeys) {
+				t1.Errorf("Len() = %v, want %v", got, len(tt.wantKeys))
+			}
+			if got, ok := tree.GetValue(tt.key); ok != tt.wantExists || got != tt.wantValue {
+				t1.Errorf("GetValue(%v) = %v, %v, want %v, %v", tt.key, got, ok, tt.wantValue, tt.wantExists)
+			}
+		})
+	}
+}
+
+func TestTree_GetOrInsert(t1 *testing.T) {
+	tree := New[string, []int](WithAVL())
+	calls := 0
+	factory := func() []int {
+		calls++
+		return []int{}
+	}
+
+	for i, key := range []string{"a", "b", "a", "a"} {
+		values, _ := tree.GetOrInsert(key, factory)
+		tree.Insert(key, append(values, i))
+	}
+
+	if calls != 2 {
+		t1.Errorf("factory calls = %v, want 2", calls)
+	}
+	if got, loaded := tree.GetOrInsert("a", factory); !loaded || !reflect.DeepEqual(got, []int{0, 2, 3}) {
+		t1.Errorf("GetOrInsert(a) = %v, %v, want [0 2 3], true", got, loaded)
+	}
+	if _, loaded := tree.GetOrInsert("c", factory); loaded {
+		t1.Errorf("GetOrInsert(c) loaded = true, want false")
+	}
+}
+
+func TestTree_InsertIfAbsent(t1 *testing.T) {
+	tree := New[int, string](WithRedBlack())
+	if !tree.InsertIfAbsent(1, "a") {
+		t1.Errorf("InsertIfAbsent(1) = false, want true")
+	}
+	if tree.InsertIfAbsent(1, "b") {
+		t1.Errorf("InsertIfAbsent(1) of existing key = true, want false")
+	}
+	if got, _ := tree.GetValue(1); got != "a" {
+		t1.Errorf("GetValue(1) = %v, want a", got)
+	}
+	if got := tree.Len(); got != 1 {
+		t1.Errorf("Len() = %v, want 1", got)
+	}
+}
+
+func TestTree_Locate(t1 *testing.T) {
+	tree := New[int, int]()
+	for _, key := range []int{10, 5, 15} {
+		tree.Insert(key, key)
+	}
+
+	if n, parent, place := tree.locate(5); n != tree.root.left || parent != nil || place != nil {
+		t1.Errorf("locate(5) = %v, %v, %v, want node 5, nil, nil", n, parent, place)
+	}
+	if n, parent, place := tree.locate(12); n != nil || parent != tree.root.right || place != &tree.root.right.left {
+		t1.Errorf("locate(12) = %v, %v, %v, want nil, node 15 and its left child place", n, parent, place)
+	}
+}
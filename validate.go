@@ -0,0 +1,117 @@
+package tree
+
+import "fmt"
+
+// Validate is a function for checking the structure of the tree, it is useful in tests and for debugging.
+// It checks order of keys, parent pointers, cached sizes and heights of subtrees
+// and the invariants of AVL or red-black tree.
+// The tree is walked without recursion, so even a degenerate tree doesn't overflow the stack.
+// - returns nil for the valid tree or *KeyError wrapping ErrInvalidTree with the first violating key
+func (t *Tree[K, V]) Validate() error {
+	if t.root == nil {
+		return nil
+	}
+
+	if t.root.parent != nil {
+		return invalid(t.root, "root has parent %v", t.root.parent.element.key)
+	}
+	if t.balance == redBlack && isRed(t.root) {
+		return invalid(t.root, "root is red")
+	}
+
+	if err := t.validateLinks(); err != nil {
+		return err
+	}
+
+	return t.validateSubtrees()
+}
+
+// validateLinks checks order of keys, parent pointers and colors of nodes from the root down.
+func (t *Tree[K, V]) validateLinks() error {
+	// lo and hi are the nearest ancestors which bound keys of the subtree (nil means no bound)
+	type item struct {
+		n, lo, hi *node[K, V]
+	}
+
+	stack := []item{{n: t.root}}
+	for len(stack) > 0 {
+		it := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		n := it.n
+
+		if it.lo != nil && t.cmp(n.element.key, it.lo.element.key) <= 0 {
+			return invalid(n, "key isn't greater than %v", it.lo.element.key)
+		}
+		if it.hi != nil && t.cmp(n.element.key, it.hi.element.key) >= 0 {
+			return invalid(n, "key isn't less than %v", it.hi.element.key)
+		}
+		for _, child := range []*node[K, V]{n.left, n.right} {
+			if child != nil && child.parent != n {
+				return invalid(n, "child %v has another parent", child.element.key)
+			}
+		}
+		if t.balance == redBlack && isRed(n) && (isRed(n.left) || isRed(n.right)) {
+			return invalid(n, "red node has red child")
+		}
+
+		if n.right != nil {
+			stack = append(stack, item{n: n.right, lo: n, hi: it.hi})
+		}
+		if n.left != nil {
+			stack = append(stack, item{n: n.left, lo: it.lo, hi: n})
+		}
+	}
+
+	return nil
+}
+
+// validateSubtrees checks cached sizes and heights, AVL balance and black heights.
+// Nodes are visited in postOrder by parent pointers (checked by validateLinks),
+// so children are checked before their parent.
+func (t *Tree[K, V]) validateSubtrees() error {
+	for n := postOrderFirst(t.root); n != nil; n = postOrderNext(n) {
+		if want := size(n.left) + 1 + size(n.right); n.size != want {
+			return invalid(n, "cached size %d, want %d", n.size, want)
+		}
+		want := height(n.left) + 1
+		if height(n.right) >= height(n.left) {
+			want = height(n.right) + 1
+		}
+		if n.height != want {
+			return invalid(n, "cached height %d, want %d", n.height, want)
+		}
+		if bf := n.balanceFactor(); t.balance == avl && (bf > 1 || bf < -1) {
+			return invalid(n, "balance factor %d", bf)
+		}
+		if t.balance != redBlack {
+			continue
+		}
+		// subtrees of children are already checked, so any path gives their black height
+		if left, right := blackHeight(n.left), blackHeight(n.right); left != right {
+			return invalid(n, "black height of left subtree %d, right subtree %d", left, right)
+		}
+	}
+
+	return nil
+}
+
+// blackHeight is the number of black nodes on the leftmost path of the subtree (nil leaves are black).
+func blackHeight[K, V any](n *node[K, V]) int {
+	h := 1
+	for ; n != nil; n = n.left {
+		if !isRed(n) {
+			h++
+		}
+	}
+
+	return h
+}
+
+// invalid makes the error of Validate about node n.
+func invalid[K, V any](n *node[K, V], format string, args ...any) error {
+	return &KeyError[K]{
+		Op:  "Validate",
+		Key: n.element.key,
+		Err: fmt.Errorf("%w: "+format, append([]any{ErrInvalidTree}, args...)...),
+	}
+}
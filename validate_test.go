@@ -0,0 +1,150 @@
+package tree
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestTree_Validate(t1 *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{name: "plain"},
+		{name: "avl", opts: []Option{WithAVL()}},
+		{name: "red-black", opts: []Option{WithRedBlack()}},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			tree := New[int, int](tt.opts...)
+			if err := tree.Validate(); err != nil {
+				t1.Fatalf("Validate() of empty tree = %v", err)
+			}
+
+			r := rand.New(rand.NewSource(4))
+			for i := 0; i < 2000; i++ {
+				key := r.Intn(200)
+				if r.Intn(3) == 0 {
+					tree.Delete(key)
+				} else {
+					tree.Insert(key, key)
+				}
+				if err := tree.Validate(); err != nil {
+					t1.Fatalf("Validate() after operation %d = %v", i, err)
+				}
+			}
+		})
+	}
+}
+
+func TestTree_ValidateBrokenTree(t1 *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Option
+		breakFn func(t *Tree[int, int])
+		wantKey int
+		wantMsg string
+	}{
+		{
+			name:    "order of keys",
+			breakFn: func(t *Tree[int, int]) { t.root.left.right.element.key = 9 },
+			wantKey: 9,
+			wantMsg: "key isn't less than 8",
+		},
+		{
+			name:    "parent pointer",
+			breakFn: func(t *Tree[int, int]) { t.root.right.left.parent = t.root },
+			wantKey: 12,
+			wantMsg: "child 10 has another parent",
+		},
+		{
+			name:    "lost subtree",
+			breakFn: func(t *Tree[int, int]) { t.root.right.right = nil },
+			wantKey: 12,
+			wantMsg: "cached size 3, want 2",
+		},
+		{
+			name:    "cached height",
+			breakFn: func(t *Tree[int, int]) { t.root.left.height = 5 },
+			wantKey: 4,
+			wantMsg: "cached height 5, want 2",
+		},
+		{
+			name:    "avl balance",
+			opts:    []Option{WithAVL()},
+			breakFn: func(t *Tree[int, int]) { rotateAndKeepRoot(t, t.root.left) },
+			wantKey: 2,
+			wantMsg: "balance factor",
+		},
+		{
+			name:    "red-black colors",
+			opts:    []Option{WithRedBlack()},
+			breakFn: func(t *Tree[int, int]) { t.root.left.left.color = black },
+			wantKey: 4,
+			wantMsg: "black height",
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			tree := New[int, int](tt.opts...)
+			for _, key := range []int{8, 4, 12, 2, 6, 10, 14} {
+				tree.Insert(key, key)
+			}
+			if err := tree.Validate(); err != nil {
+				t1.Fatalf("Validate() before breaking = %v", err)
+			}
+
+			tt.breakFn(tree)
+			err := tree.Validate()
+			if !errors.Is(err, ErrInvalidTree) {
+				t1.Fatalf("Validate() = %v, want %v", err, ErrInvalidTree)
+			}
+			var keyErr *KeyError[int]
+			if !errors.As(err, &keyErr) || keyErr.Key != tt.wantKey {
+				t1.Errorf("Validate() = %v, want error about key %v", err, tt.wantKey)
+			}
+			if !strings.Contains(err.Error(), tt.wantMsg) {
+				t1.Errorf("Validate() = %q, want message with %q", err.Error(), tt.wantMsg)
+			}
+		})
+	}
+}
+
+// rotateAndKeepRoot rotates subtree n to the right and recalculates cached values,
+// so that the tree stays ordered but gets unbalanced.
+func rotateAndKeepRoot(t *Tree[int, int], n *node[int, int]) {
+	t.rotateRight(n)
+	updateToRoot(n)
+}
+
+func TestMultiTree_Validate(t1 *testing.T) {
+	m := NewMulti[int, int](WithRedBlack())
+	for i := 0; i < 100; i++ {
+		m.Insert(i%10, i)
+	}
+	if err := m.Validate(); err != nil {
+		t1.Fatalf("Validate() = %v", err)
+	}
+
+	m.len++
+	if err := m.Validate(); !errors.Is(err, ErrInvalidTree) {
+		t1.Errorf("Validate() with wrong number of elements = %v, want %v", err, ErrInvalidTree)
+	}
+}
+
+func TestTree_ValidateDegenerateTree(t1 *testing.T) {
+	tree := New[int, int]()
+	for i := 0; i < 10000; i++ {
+		tree.InsertWithoutRecursion(i, i)
+	}
+
+	if err := tree.Validate(); err != nil {
+		t1.Fatalf("Validate() = %v", err)
+	}
+	max(tree.root).size = 2
+	if err := tree.Validate(); !errors.Is(err, ErrInvalidTree) {
+		t1.Errorf("Validate() of broken degenerate tree = %v, want %v", err, ErrInvalidTree)
+	}
+}